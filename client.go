@@ -14,6 +14,7 @@ import (
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/gamma"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Client aggregates service clients behind a shared configuration.
@@ -28,8 +29,12 @@ type Client struct {
 	RTDS   rtds.Client
 	CTF    ctf.Client
 
-	builderCfg *auth.BuilderConfig
-	InitErrors []error
+	// CLOBReadonly is a query-only CLOB client, set by WithReadonlyAuth.
+	CLOBReadonly clob.ReadonlyClient
+
+	builderCfg   *auth.BuilderConfig
+	readonlyAuth *readonlyAuth
+	InitErrors   []error
 }
 
 // InitError records a non-fatal client initialization failure for a sub-service.
@@ -130,6 +135,20 @@ func newClient(strict bool, opts ...Option) (*Client, error) {
 		c.CLOB = c.CLOB.WithBuilderConfig(c.builderCfg)
 	}
 
+	// 6. Build the query-only view; the key is not checked with the exchange here
+	if c.readonlyAuth != nil && c.CLOB != nil {
+		switch {
+		case c.readonlyAuth.address == (common.Address{}):
+			c.InitErrors = append(c.InitErrors, &InitError{Component: "clob_readonly", Err: fmt.Errorf("address is required")})
+		case c.readonlyAuth.apiKey == nil || c.readonlyAuth.apiKey.Key == "":
+			c.InitErrors = append(c.InitErrors, &InitError{Component: "clob_readonly", Err: auth.ErrMissingCreds})
+		default:
+			// L2 headers only use the signer's address, so the chain does not matter.
+			signer := auth.NewWatchOnlySigner(c.readonlyAuth.address, auth.PolygonChainID)
+			c.CLOBReadonly = clob.NewReadonlyClient(c.CLOB.WithHeartbeatInterval(0).WithAuth(signer, c.readonlyAuth.apiKey))
+		}
+	}
+
 	if strict && len(c.InitErrors) > 0 {
 		return c, errors.Join(c.InitErrors...)
 	}
@@ -1,11 +1,19 @@
 package polymarket
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
 )
 
@@ -157,6 +165,47 @@ func TestWithAuthDoesNotMutateOriginalClient(t *testing.T) {
 	}
 }
 
+func TestWithReadonlyAuth(t *testing.T) {
+	address := common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		json.NewEncoder(w).Encode(clobtypes.ValidateReadonlyAPIKeyResponse{Valid: true})
+	}))
+	defer srv.Close()
+	cfg := invalidStreamingConfig()
+	cfg.BaseURLs.CLOB = srv.URL
+
+	c := NewClient(WithConfig(cfg), WithReadonlyAuth(address, &auth.APIKey{Key: "ro", Secret: "s", Passphrase: "p"}))
+	if c.CLOBReadonly == nil {
+		t.Fatal("expected CLOBReadonly to be set")
+	}
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("NewClient should not call the exchange, got %d requests", n)
+	}
+	if _, ok := c.CLOBReadonly.(clob.Client); ok {
+		t.Fatal("CLOBReadonly must not expose write methods")
+	}
+	if NewClient(WithConfig(cfg)).CLOBReadonly != nil {
+		t.Fatal("CLOBReadonly should be nil without WithReadonlyAuth")
+	}
+
+	missing := NewClient(WithConfig(cfg), WithReadonlyAuth(address, nil))
+	if missing.CLOBReadonly != nil {
+		t.Fatal("CLOBReadonly should be nil without a key")
+	}
+	var initErr *InitError
+	found := false
+	for _, err := range missing.InitErrors {
+		if errors.As(err, &initErr) && initErr.Component == "clob_readonly" {
+			found = errors.Is(err, auth.ErrMissingCreds)
+		}
+	}
+	if !found {
+		t.Fatalf("expected clob_readonly init error, got %v", missing.InitErrors)
+	}
+}
+
 func TestWithAuthClonesWSClientWhenSupported(t *testing.T) {
 	cfg := invalidStreamingConfig()
 	base := NewClient(WithConfig(cfg))
@@ -29,15 +29,11 @@ func main() {
 	cfg.DryRun = !*execute
 	cfg.RequireExplicitConfirm = !*yes
 
-	pk := strings.TrimSpace(os.Getenv("POLYMARKET_PK"))
-	if pk == "" {
-		log.Fatal("missing POLYMARKET_PK")
-	}
-
-	signer, err := auth.NewPrivateKeySigner(pk, 137)
+	signer, err := loadSigner(137)
 	if err != nil {
 		log.Fatalf("create signer failed: %v", err)
 	}
+	defer signer.Close()
 
 	apiKey := &auth.APIKey{
 		Key:        strings.TrimSpace(os.Getenv("POLYMARKET_API_KEY")),
@@ -121,6 +117,16 @@ func main() {
 	fmt.Printf("Order submitted: id=%s status=%s\n", resp.ID, resp.Status)
 }
 
+// loadSigner builds the signer from the POLYMARKET_ keystore, mnemonic or
+// private key variables; see auth.SignerFromEnv.
+func loadSigner(chainID int64) (*auth.PrivateKeySigner, error) {
+	signer, err := auth.SignerFromEnv("POLYMARKET_", chainID)
+	if err == nil && signer == nil {
+		err = fmt.Errorf("missing POLYMARKET_KEYSTORE, POLYMARKET_MNEMONIC or POLYMARKET_PK")
+	}
+	return signer, err
+}
+
 func confirm(prompt string) bool {
 	fmt.Print(prompt)
 	r := bufio.NewReader(os.Stdin)
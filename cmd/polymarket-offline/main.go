@@ -0,0 +1,228 @@
+// Command polymarket-offline signs orders on an air-gapped machine.
+//
+// The online machine exports a resolved unsigned order, the offline machine
+// signs it, and the online machine imports the detached signature and posts
+// the order:
+//
+//	polymarket-offline export -token 123 -side BUY -price 0.5 -size 10 -signer 0x... -out order.json
+//	polymarket-offline sign -in order.json -out order.sig
+//	polymarket-offline submit -in order.json -sig order.sig
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"strings"
+
+	polymarket "github.com/GoPolymarket/polymarket-go-sdk/v2"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "sign":
+		err = runSign(os.Args[2:])
+	case "submit":
+		err = runSubmit(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		log.Fatalf("%s failed: %v", os.Args[1], err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: polymarket-offline <export|sign|submit> [flags]")
+	os.Exit(2)
+}
+
+// runExport builds an order for an offline key and writes it unsigned.
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var (
+		token     = fs.String("token", "", "Token ID to trade")
+		side      = fs.String("side", "", "BUY or SELL")
+		price     = fs.Float64("price", 0, "Limit price")
+		size      = fs.Float64("size", 0, "Size in shares")
+		orderType = fs.String("order-type", string(clobtypes.OrderTypeGTC), "Order type")
+		signer    = fs.String("signer", "", "Address of the offline signing key")
+		sigType   = fs.Int("signature-type", int(auth.SignatureEOA), "0=EOA, 1=Proxy, 2=Safe, 3=Poly1271")
+		funder    = fs.String("funder", "", "Funder (maker) address for non-EOA signature types")
+		chainID   = fs.Int64("chain-id", auth.PolygonChainID, "Chain ID")
+		out       = fs.String("out", "unsigned-order.json", "Output file")
+	)
+	fs.Parse(args)
+	if !common.IsHexAddress(*signer) {
+		return fmt.Errorf("-signer must be an address")
+	}
+
+	client := polymarket.NewClient().CLOB.WithSignatureType(auth.SignatureType(*sigType))
+	if *funder != "" {
+		if !common.IsHexAddress(*funder) {
+			return fmt.Errorf("-funder must be an address")
+		}
+		client = client.WithFunder(common.HexToAddress(*funder))
+	}
+	watchOnly := auth.NewWatchOnlySigner(common.HexToAddress(*signer), *chainID)
+	unsigned, err := clob.NewOrderBuilder(client, watchOnly).
+		TokenID(*token).
+		Side(*side).
+		Price(*price).
+		Size(*size).
+		OrderType(clobtypes.OrderType(strings.ToUpper(*orderType))).
+		ExportUnsigned(context.Background(), strings.TrimSpace(os.Getenv("POLYMARKET_API_KEY")))
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(unsigned, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, raw, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote unsigned order to %s (digest %s)\n", *out, unsigned.Digest)
+	return nil
+}
+
+// runSign signs an exported order with the local key.
+func runSign(args []string) error {
+	fs := flag.NewFlagSet("sign", flag.ExitOnError)
+	var (
+		in  = fs.String("in", "unsigned-order.json", "Unsigned order file")
+		out = fs.String("out", "order.sig", "Signature output file")
+		yes = fs.Bool("yes", false, "Sign without asking for confirmation")
+	)
+	fs.Parse(args)
+
+	unsigned, err := readUnsigned(*in)
+	if err != nil {
+		return err
+	}
+	if err := unsigned.Verify(); err != nil {
+		return err
+	}
+	if !*yes {
+		o := unsigned.Order
+		sigType := int(auth.SignatureEOA)
+		if o.SignatureType != nil {
+			sigType = *o.SignatureType
+		}
+		fmt.Printf("Order: side=%s token=%s makerAmount=%s takerAmount=%s maker=%s signatureType=%d\n",
+			o.Side, o.TokenID.String(), o.MakerAmount.String(), o.TakerAmount.String(), o.Maker.Hex(), sigType)
+		if !confirm("Sign this order? [y/N]: ") {
+			return fmt.Errorf("canceled")
+		}
+	}
+
+	chainID := auth.PolygonChainID
+	if unsigned.TypedData.Domain.ChainId != nil {
+		chainID = (*big.Int)(unsigned.TypedData.Domain.ChainId).Int64()
+	}
+	signer, err := loadSigner(chainID)
+	if err != nil {
+		return err
+	}
+	defer signer.Close()
+
+	sig, err := clob.SignUnsignedOrder(signer, unsigned)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, []byte(sig+"\n"), 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("Wrote signature to %s\n", *out)
+	return nil
+}
+
+// runSubmit attaches a detached signature and posts the order.
+func runSubmit(args []string) error {
+	fs := flag.NewFlagSet("submit", flag.ExitOnError)
+	var (
+		in      = fs.String("in", "unsigned-order.json", "Unsigned order file")
+		sigFile = fs.String("sig", "order.sig", "Signature file")
+		dryRun  = fs.Bool("dry-run", false, "Verify the signature without posting")
+	)
+	fs.Parse(args)
+
+	unsigned, err := readUnsigned(*in)
+	if err != nil {
+		return err
+	}
+	sig, err := os.ReadFile(*sigFile)
+	if err != nil {
+		return err
+	}
+	signed, err := clob.ImportSignature(unsigned, string(sig))
+	if err != nil {
+		return err
+	}
+	if *dryRun {
+		fmt.Println("Signature verified; order not posted.")
+		return nil
+	}
+
+	apiKey := &auth.APIKey{
+		Key:        strings.TrimSpace(os.Getenv("POLYMARKET_API_KEY")),
+		Secret:     strings.TrimSpace(os.Getenv("POLYMARKET_API_SECRET")),
+		Passphrase: strings.TrimSpace(os.Getenv("POLYMARKET_API_PASSPHRASE")),
+	}
+	if apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
+		return fmt.Errorf("missing POLYMARKET_API_KEY / POLYMARKET_API_SECRET / POLYMARKET_API_PASSPHRASE")
+	}
+	chainID := (*big.Int)(unsigned.TypedData.Domain.ChainId).Int64()
+	client := polymarket.NewClient().CLOB.WithAuth(auth.NewWatchOnlySigner(unsigned.SignerAddress, chainID), apiKey)
+	resp, err := client.PostOrder(context.Background(), signed)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Order submitted: id=%s status=%s\n", resp.ID, resp.Status)
+	return nil
+}
+
+func readUnsigned(path string) (*clob.UnsignedOrder, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var unsigned clob.UnsignedOrder
+	if err := json.Unmarshal(raw, &unsigned); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", path, err)
+	}
+	return &unsigned, nil
+}
+
+// loadSigner builds the signer from the POLYMARKET_ keystore, mnemonic or
+// private key variables; see auth.SignerFromEnv.
+func loadSigner(chainID int64) (*auth.PrivateKeySigner, error) {
+	signer, err := auth.SignerFromEnv("POLYMARKET_", chainID)
+	if err == nil && signer == nil {
+		err = fmt.Errorf("missing POLYMARKET_KEYSTORE, POLYMARKET_MNEMONIC_FILE or POLYMARKET_PK")
+	}
+	return signer, err
+}
+
+func confirm(prompt string) bool {
+	fmt.Print(prompt)
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	line = strings.TrimSpace(strings.ToLower(line))
+	return line == "y" || line == "yes"
+}
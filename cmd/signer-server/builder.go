@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+)
+
+const (
+	builderSignPath           = "/v1/sign-builder"
+	defaultBuilderMethodsList = "GET,POST,DELETE"
+)
+
+// SignRequest is the payload sent by auth.BuilderRemoteConfig.
+type SignRequest struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	Body      string `json:"body"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// builderService signs builder attribution headers for authenticated
+// clients.
+type builderService struct {
+	creds          auth.BuilderCredentials
+	clients        *clientAuth
+	allowedMethods map[string]bool
+	// allowedPaths holds exact paths, or prefixes when they end in "*".
+	// Empty allows every path.
+	allowedPaths []string
+	insecure     bool
+	audit        *auditLog
+	metrics      *metrics
+	now          func() time.Time
+}
+
+// builderServiceFromEnv configures builder signing from BUILDER_KEY,
+// BUILDER_SECRET and BUILDER_PASSPHRASE for the callers in clients.
+// BUILDER_ALLOWED_METHODS and BUILDER_ALLOWED_PATHS restrict what may be
+// signed. It returns nil when the builder credentials are unset.
+func builderServiceFromEnv(clients *clientAuth, audit *auditLog, m *metrics, insecure bool) (*builderService, error) {
+	creds := auth.BuilderCredentials{
+		Key:        os.Getenv("BUILDER_KEY"),
+		Secret:     os.Getenv("BUILDER_SECRET"),
+		Passphrase: os.Getenv("BUILDER_PASSPHRASE"),
+	}
+	if creds.Key == "" || creds.Secret == "" || creds.Passphrase == "" {
+		return nil, nil
+	}
+	if len(clients.clients) == 0 && !insecure {
+		return nil, fmt.Errorf("builder signing requires CLIENT_TOKENS or CLIENT_HMAC_SECRETS; set ALLOW_INSECURE=true to override")
+	}
+
+	methods := os.Getenv("BUILDER_ALLOWED_METHODS")
+	if methods == "" {
+		methods = defaultBuilderMethodsList
+	}
+	return &builderService{
+		creds:          creds,
+		clients:        clients,
+		allowedMethods: splitSet(methods, true),
+		allowedPaths:   splitList(os.Getenv("BUILDER_ALLOWED_PATHS")),
+		insecure:       insecure,
+		audit:          audit,
+		metrics:        m,
+		now:            time.Now,
+	}, nil
+}
+
+func (s *builderService) register(mux *http.ServeMux) {
+	mux.HandleFunc(builderSignPath, s.handleSign)
+}
+
+func (s *builderService) handleSign(w http.ResponseWriter, r *http.Request) {
+	entry := AuditEntry{
+		Time:       s.now().UTC(),
+		Endpoint:   builderSignPath,
+		RemoteAddr: r.RemoteAddr,
+		Signer:     s.creds.Key,
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxSignBodyBytes))
+	if err != nil {
+		s.reject(w, entry, http.StatusBadRequest, "invalid body")
+		return
+	}
+
+	client, status, reason := s.clients.admit(r, body, s.insecure)
+	if client != nil {
+		entry.Client = client.id
+	}
+	if status != 0 {
+		s.reject(w, entry, status, reason)
+		return
+	}
+
+	var req SignRequest
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&req); err != nil {
+		s.reject(w, entry, http.StatusBadRequest, "invalid body")
+		return
+	}
+	entry.Method, entry.Path = req.Method, req.Path
+	if !s.allowedMethods[strings.ToUpper(req.Method)] {
+		s.reject(w, entry, http.StatusForbidden, "method not allowed: "+req.Method)
+		return
+	}
+	if !s.pathAllowed(req.Path) {
+		s.reject(w, entry, http.StatusForbidden, "path not allowed: "+req.Path)
+		return
+	}
+	if !s.clients.fresh(req.Timestamp) {
+		s.reject(w, entry, http.StatusBadRequest, "stale or future timestamp")
+		return
+	}
+
+	var reqBody *string
+	if req.Body != "" {
+		reqBody = &req.Body
+	}
+	headers, err := (&auth.BuilderConfig{Local: &s.creds}).Headers(r.Context(), req.Method, req.Path, reqBody, req.Timestamp)
+	if err != nil {
+		log.Printf("Signing error: %v", err)
+		s.reject(w, entry, http.StatusInternalServerError, "signing failed")
+		return
+	}
+
+	entry.Decision = "signed"
+	s.audit.Write(entry)
+	s.metrics.observe(entry.Endpoint, entry.Client, entry.Decision, s.now().Sub(entry.Time))
+	writeJSON(w, map[string]string{
+		auth.HeaderPolyBuilderAPIKey:     headers.Get(auth.HeaderPolyBuilderAPIKey),
+		auth.HeaderPolyBuilderPassphrase: headers.Get(auth.HeaderPolyBuilderPassphrase),
+		auth.HeaderPolyBuilderTimestamp:  headers.Get(auth.HeaderPolyBuilderTimestamp),
+		auth.HeaderPolyBuilderSignature:  headers.Get(auth.HeaderPolyBuilderSignature),
+	})
+}
+
+func (s *builderService) pathAllowed(path string) bool {
+	if len(s.allowedPaths) == 0 {
+		return true
+	}
+	for _, allowed := range s.allowedPaths {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		} else if path == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *builderService) reject(w http.ResponseWriter, entry AuditEntry, status int, reason string) {
+	entry.Decision = "denied"
+	if status >= http.StatusInternalServerError {
+		entry.Decision = "error"
+	}
+	entry.Reason = reason
+	s.audit.Write(entry)
+	s.metrics.observe(entry.Endpoint, entry.Client, entry.Decision, s.now().Sub(entry.Time))
+	http.Error(w, reason, status)
+}
+
+func splitList(raw string) []string {
+	var out []string
+	for _, v := range strings.Split(raw, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+func TestBuilderServiceAuthenticatesClients(t *testing.T) {
+	secret := base64.URLEncoding.EncodeToString([]byte("builder-secret"))
+	clientSecret := base64.URLEncoding.EncodeToString([]byte("client-secret"))
+	var audit bytes.Buffer
+	m := newMetrics()
+	svc := &builderService{
+		creds:          auth.BuilderCredentials{Key: "builder-key", Secret: secret, Passphrase: "pass"},
+		clients:        &clientAuth{clients: make(map[string]*signerClient), maxSkew: defaultMaxClockSkew, now: time.Now},
+		allowedMethods: splitSet("GET,POST", true),
+		allowedPaths:   []string{"/order", "/orders*"},
+		audit:          newAuditLog(&audit),
+		metrics:        m,
+		now:            time.Now,
+	}
+	if err := svc.clients.addClients("bot:tok-1", 100, func(c *signerClient, v string) { c.token = v }); err != nil {
+		t.Fatalf("addClients: %v", err)
+	}
+	if err := svc.clients.addClients("hmac-bot:"+clientSecret, 100, func(c *signerClient, v string) { c.secret = v }); err != nil {
+		t.Fatalf("addClients: %v", err)
+	}
+	mux := http.NewServeMux()
+	svc.register(mux)
+	mux.Handle("GET /metrics", m)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	body := `{"market":"0x1"}`
+	ts := time.Now().Unix()
+	headersFor := func(remote auth.BuilderRemoteConfig, method, path string) (http.Header, error) {
+		remote.Host = srv.URL + builderSignPath
+		return (&auth.BuilderConfig{Remote: &remote}).Headers(ctx, method, path, &body, ts)
+	}
+
+	want, err := (&auth.BuilderConfig{Local: &svc.creds}).Headers(ctx, "POST", "/order", &body, ts)
+	if err != nil {
+		t.Fatalf("local headers: %v", err)
+	}
+	for _, remote := range []auth.BuilderRemoteConfig{
+		{Token: "tok-1"},
+		{ClientID: "hmac-bot", ClientSecret: clientSecret},
+	} {
+		got, err := headersFor(remote, "POST", "/order")
+		if err != nil {
+			t.Fatalf("remote headers with %+v: %v", remote, err)
+		}
+		if got.Get(auth.HeaderPolyBuilderSignature) != want.Get(auth.HeaderPolyBuilderSignature) {
+			t.Fatalf("signature mismatch: got %q want %q", got.Get(auth.HeaderPolyBuilderSignature), want.Get(auth.HeaderPolyBuilderSignature))
+		}
+	}
+
+	if _, err := headersFor(auth.BuilderRemoteConfig{Token: "wrong"}, "POST", "/order"); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized for bad token, got %v", err)
+	}
+	if _, err := headersFor(auth.BuilderRemoteConfig{ClientID: "hmac-bot", ClientSecret: secret}, "POST", "/order"); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized for bad client secret, got %v", err)
+	}
+	if _, err := headersFor(auth.BuilderRemoteConfig{}, "POST", "/order"); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized without credentials, got %v", err)
+	}
+	if _, err := headersFor(auth.BuilderRemoteConfig{Token: "tok-1"}, "DELETE", "/order"); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected forbidden method, got %v", err)
+	}
+	if _, err := headersFor(auth.BuilderRemoteConfig{Token: "tok-1"}, "POST", "/auth/api-key"); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected forbidden path, got %v", err)
+	}
+	if _, err := headersFor(auth.BuilderRemoteConfig{Token: "tok-1"}, "GET", "/orders/123"); err != nil {
+		t.Fatalf("prefix path rejected: %v", err)
+	}
+
+	stale := time.Now().Add(-time.Hour).Unix()
+	remote := &auth.BuilderRemoteConfig{Host: srv.URL + builderSignPath, Token: "tok-1"}
+	if _, err := (&auth.BuilderConfig{Remote: remote}).Headers(ctx, "POST", "/order", &body, stale); err == nil || !strings.Contains(err.Error(), "400") {
+		t.Fatalf("expected stale timestamp rejection, got %v", err)
+	}
+
+	// A signature over a different body must not authenticate the request.
+	raw := []byte(`{"method":"POST","path":"/order","body":"","timestamp":` + jsonInt(ts) + `}`)
+	sig, _ := auth.SignRemoteRequest(clientSecret, ts, http.MethodPost, builderSignPath, []byte("{}"))
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+builderSignPath, bytes.NewReader(raw))
+	req.Header.Set(auth.HeaderSignerClientID, "hmac-bot")
+	req.Header.Set(auth.HeaderSignerTimestamp, jsonInt(ts))
+	req.Header.Set(auth.HeaderSignerSignature, sig)
+	if resp, err := srv.Client().Do(req); err != nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected tampered body to be rejected, got %v %v", resp.StatusCode, err)
+	}
+
+	var entries []AuditEntry
+	dec := json.NewDecoder(&audit)
+	for dec.More() {
+		var e AuditEntry
+		if err := dec.Decode(&e); err != nil {
+			t.Fatalf("decode audit: %v", err)
+		}
+		entries = append(entries, e)
+	}
+	if len(entries) != 10 {
+		t.Fatalf("expected 10 audit entries, got %d", len(entries))
+	}
+	if e := entries[0]; e.Decision != "signed" || e.Client != "bot" || e.Method != "POST" || e.Path != "/order" {
+		t.Fatalf("unexpected audit entry %+v", e)
+	}
+	if e := entries[6]; e.Decision != "denied" || !strings.Contains(e.Reason, "path not allowed") {
+		t.Fatalf("unexpected audit entry %+v", e)
+	}
+
+	resp, err := srv.Client().Get(srv.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	out, _ := io.ReadAll(resp.Body)
+	for _, line := range []string{
+		`signer_requests_total{endpoint="/v1/sign-builder",client="bot",decision="signed"} 2`,
+		`signer_requests_total{endpoint="/v1/sign-builder",client="hmac-bot",decision="signed"} 1`,
+		`signer_request_duration_seconds_count{endpoint="/v1/sign-builder"} 10`,
+	} {
+		if !strings.Contains(string(out), line) {
+			t.Fatalf("metrics missing %q:\n%s", line, out)
+		}
+	}
+}
+
+func TestBuilderServiceRateLimitsClients(t *testing.T) {
+	svc := &builderService{
+		creds: auth.BuilderCredentials{Key: "k", Secret: base64.URLEncoding.EncodeToString([]byte("s")), Passphrase: "p"},
+		clients: &clientAuth{
+			clients: map[string]*signerClient{"bot": {id: "bot", token: "tok", limiter: transport.NewRateLimiter(1)}},
+			maxSkew: defaultMaxClockSkew,
+			now:     time.Now,
+		},
+		allowedMethods: splitSet(defaultBuilderMethodsList, true),
+		audit:          newAuditLog(io.Discard),
+		now:            time.Now,
+	}
+	mux := http.NewServeMux()
+	svc.register(mux)
+
+	send := func() int {
+		raw := `{"method":"GET","path":"/data/orders","body":"","timestamp":` + jsonInt(time.Now().Unix()) + `}`
+		req := httptest.NewRequest(http.MethodPost, builderSignPath, strings.NewReader(raw))
+		req.Header.Set("Authorization", "Bearer tok")
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	if code := send(); code != http.StatusOK {
+		t.Fatalf("first request: status %d", code)
+	}
+	if code := send(); code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", code)
+	}
+}
+
+func jsonInt(v int64) string {
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+const (
+	defaultClientRateLimit = 10
+	defaultMaxClockSkew    = 30 * time.Second
+)
+
+// signerClient is a caller allowed to request signatures.
+type signerClient struct {
+	id      string
+	token   string
+	secret  string
+	limiter *transport.RateLimiter
+}
+
+// clientAuth authenticates callers of every signing endpoint and rate
+// limits each of them.
+type clientAuth struct {
+	clients map[string]*signerClient
+	maxSkew time.Duration
+	now     func() time.Time
+}
+
+// clientAuthFromEnv loads clients from CLIENT_TOKENS (id:token pairs, sent as
+// bearer tokens) and CLIENT_HMAC_SECRETS (id:secret pairs with base64
+// secrets), each limited to CLIENT_RATE_LIMIT requests per second.
+// MAX_CLOCK_SKEW bounds the age of HMAC-signed requests.
+func clientAuthFromEnv() (*clientAuth, error) {
+	rate := defaultClientRateLimit
+	if raw := os.Getenv("CLIENT_RATE_LIMIT"); raw != "" {
+		v, err := strconv.Atoi(raw)
+		if err != nil || v <= 0 {
+			return nil, fmt.Errorf("invalid CLIENT_RATE_LIMIT %q", raw)
+		}
+		rate = v
+	}
+	a := &clientAuth{
+		clients: make(map[string]*signerClient),
+		maxSkew: defaultMaxClockSkew,
+		now:     time.Now,
+	}
+	if raw := os.Getenv("MAX_CLOCK_SKEW"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid MAX_CLOCK_SKEW %q", raw)
+		}
+		a.maxSkew = d
+	}
+	if err := a.addClients(os.Getenv("CLIENT_TOKENS"), rate, func(c *signerClient, v string) { c.token = v }); err != nil {
+		return nil, fmt.Errorf("CLIENT_TOKENS: %w", err)
+	}
+	if err := a.addClients(os.Getenv("CLIENT_HMAC_SECRETS"), rate, func(c *signerClient, v string) { c.secret = v }); err != nil {
+		return nil, fmt.Errorf("CLIENT_HMAC_SECRETS: %w", err)
+	}
+	return a, nil
+}
+
+func (a *clientAuth) addClients(list string, rate int, set func(*signerClient, string)) error {
+	for _, pair := range splitList(list) {
+		id, value, ok := strings.Cut(pair, ":")
+		if !ok || id == "" || value == "" {
+			return fmt.Errorf("expected id:value, got %q", pair)
+		}
+		c := a.clients[id]
+		if c == nil {
+			c = &signerClient{id: id, limiter: transport.NewRateLimiter(rate)}
+			a.clients[id] = c
+		}
+		set(c, value)
+	}
+	return nil
+}
+
+// admit authenticates and rate limits a request. It returns the status and
+// reason to reject it with, or a zero status. Requests without credentials
+// are admitted with a nil client only when no clients are configured and
+// anonymous is set.
+func (a *clientAuth) admit(r *http.Request, body []byte, anonymous bool) (*signerClient, int, string) {
+	client, reason := a.authenticate(r, body, anonymous)
+	if reason != "" {
+		return nil, http.StatusUnauthorized, reason
+	}
+	if client != nil && !client.limiter.TryAcquire() {
+		return client, http.StatusTooManyRequests, "rate limit exceeded"
+	}
+	return client, 0, ""
+}
+
+// authenticate identifies the caller by bearer token or HMAC signature. It
+// returns a non-empty reason when the request must be rejected.
+func (a *clientAuth) authenticate(r *http.Request, body []byte, anonymous bool) (*signerClient, string) {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		for _, c := range a.clients {
+			if c.token != "" && subtle.ConstantTimeCompare([]byte(c.token), []byte(token)) == 1 {
+				return c, ""
+			}
+		}
+		return nil, "invalid token"
+	}
+	if id := r.Header.Get(auth.HeaderSignerClientID); id != "" {
+		c := a.clients[id]
+		if c == nil || c.secret == "" {
+			return nil, "unknown client"
+		}
+		ts, err := strconv.ParseInt(r.Header.Get(auth.HeaderSignerTimestamp), 10, 64)
+		if err != nil || !a.fresh(ts) {
+			return nil, "stale or missing client timestamp"
+		}
+		want, err := auth.SignRemoteRequest(c.secret, ts, r.Method, r.URL.Path, body)
+		if err != nil || !hmac.Equal([]byte(want), []byte(r.Header.Get(auth.HeaderSignerSignature))) {
+			return nil, "invalid client signature"
+		}
+		return c, ""
+	}
+	if len(a.clients) == 0 && anonymous {
+		return nil, ""
+	}
+	return nil, "missing client credentials"
+}
+
+// fresh reports whether the Unix timestamp ts is within maxSkew of now.
+func (a *clientAuth) fresh(ts int64) bool {
+	skew := a.now().Sub(time.Unix(ts, 0))
+	return skew <= a.maxSkew && skew >= -a.maxSkew
+}
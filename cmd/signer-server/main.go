@@ -1,86 +1,172 @@
 package main
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
 )
 
-// Request payload matching what the SDK sends
-type SignRequest struct {
-	Method    string `json:"method"`
-	Path      string `json:"path"`
-	Body      string `json:"body"`
-	Timestamp int64  `json:"timestamp"`
-}
-
-// Env vars
-var (
-	BuilderKey        = os.Getenv("BUILDER_KEY")
-	BuilderSecret     = os.Getenv("BUILDER_SECRET")
-	BuilderPassphrase = os.Getenv("BUILDER_PASSPHRASE")
-)
+const shutdownTimeout = 10 * time.Second
 
 func main() {
-	if BuilderKey == "" || BuilderSecret == "" || BuilderPassphrase == "" {
-		log.Fatal("Missing BUILDER_KEY, BUILDER_SECRET, or BUILDER_PASSPHRASE env vars")
+	insecure := strings.EqualFold(os.Getenv("ALLOW_INSECURE"), "true")
+	audit, closeAudit, err := openAuditLog()
+	if err != nil {
+		log.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer closeAudit()
+	m := newMetrics()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", handleHealth)
+
+	clients, err := clientAuthFromEnv()
+	if err != nil {
+		log.Fatalf("Invalid client configuration: %v", err)
+	}
+	builder, err := builderServiceFromEnv(clients, audit, m, insecure)
+	if err != nil {
+		log.Fatalf("Invalid builder signing configuration: %v", err)
+	}
+	if builder != nil {
+		builder.register(mux)
+	}
+
+	signer, err := loadOrderSigner()
+	if err != nil {
+		log.Fatalf("Failed to load order signing key: %v", err)
+	}
+	if signer != nil {
+		defer signer.Close()
+		policy, err := policyFromEnv(signer.ChainID().Int64())
+		if err != nil {
+			log.Fatalf("Invalid policy: %v", err)
+		}
+		(&orderService{signer: signer, policy: policy, clients: clients, audit: audit, metrics: m}).register(mux)
+		fmt.Printf("Order signing enabled for %s\n", signer.Address().Hex())
+	}
+	if builder == nil && signer == nil {
+		log.Fatal("Missing BUILDER_KEY, BUILDER_SECRET, BUILDER_PASSPHRASE or an order signing key (SIGNER_KEYSTORE, SIGNER_MNEMONIC_FILE, SIGNER_PK)")
 	}
 
-	http.HandleFunc("/v1/sign-builder", handleSign)
-	http.HandleFunc("/health", handleHealth)
+	tlsConfig, err := loadTLSConfig()
+	if err != nil {
+		log.Fatalf("Invalid TLS configuration: %v", err)
+	}
+	if signer != nil && tlsConfig == nil && !insecure {
+		log.Fatal("Order signing requires mutual TLS (TLS_CERT_FILE, TLS_KEY_FILE, TLS_CLIENT_CA_FILE); set ALLOW_INSECURE=true to override")
+	}
 
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
-	fmt.Printf("Signer service running on port %s\n", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
-}
+	server := &http.Server{Addr: ":" + port, Handler: mux, TLSConfig: tlsConfig}
 
-func handleHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("OK"))
-}
+	// Metrics carry client IDs and decision counts, so they are never served
+	// next to the signing endpoints. METRICS_ADDR should be a private or
+	// loopback address.
+	var metricsServer *http.Server
+	if addr := os.Getenv("METRICS_ADDR"); addr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("GET /metrics", m)
+		metricsServer = &http.Server{Addr: addr, Handler: metricsMux}
+	}
 
-func handleSign(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	serveErr := make(chan error, 2)
+	go func() {
+		fmt.Printf("Signer service running on port %s\n", port)
+		if tlsConfig != nil {
+			serveErr <- server.ListenAndServeTLS("", "")
+		} else {
+			serveErr <- server.ListenAndServe()
+		}
+	}()
+	if metricsServer != nil {
+		go func() {
+			fmt.Printf("Metrics served on %s\n", metricsServer.Addr)
+			serveErr <- metricsServer.ListenAndServe()
+		}()
 	}
 
-	var req SignRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid body", http.StatusBadRequest)
-		return
+	select {
+	case err := <-serveErr:
+		log.Print(err)
+	case <-ctx.Done():
 	}
+	// Let in-flight signing requests finish and reach the audit log.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Shutdown: %v", err)
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Shutdown metrics: %v", err)
+		}
+	}
+}
 
-	// Logic from auth.BuildL2Headers but simplified for Builder
-	// Message = timestamp + method + path + body
-	message := fmt.Sprintf("%d%s%s", req.Timestamp, req.Method, req.Path)
-	if req.Body != "" {
-		message += req.Body
+// loadOrderSigner loads the order signing key for CHAIN_ID from the SIGNER_
+// keystore, mnemonic or private key variables; see auth.SignerFromEnv. It
+// returns nil when none is set.
+func loadOrderSigner() (*auth.PrivateKeySigner, error) {
+	chainID := auth.PolygonChainID
+	if raw := os.Getenv("CHAIN_ID"); raw != "" {
+		v, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CHAIN_ID %q", raw)
+		}
+		chainID = v
 	}
 
-	// Sign using the Secret (held securely on this server)
-	sig, err := auth.SignHMAC(BuilderSecret, message)
+	return auth.SignerFromEnv("SIGNER_", chainID)
+}
+
+// loadTLSConfig returns a mutual TLS configuration when TLS_CERT_FILE,
+// TLS_KEY_FILE and TLS_CLIENT_CA_FILE are set, and nil when none are.
+func loadTLSConfig() (*tls.Config, error) {
+	certFile, keyFile, caFile := os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE"), os.Getenv("TLS_CLIENT_CA_FILE")
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return nil, fmt.Errorf("TLS_CERT_FILE, TLS_KEY_FILE and TLS_CLIENT_CA_FILE must be set together")
+	}
+	cfg, err := auth.LoadMutualTLSConfig(certFile, keyFile, caFile)
 	if err != nil {
-		log.Printf("Signing error: %v", err)
-		http.Error(w, "Internal error", http.StatusInternalServerError)
-		return
+		return nil, err
 	}
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg, nil
+}
 
-	// Return the headers needed by Polymarket
-	resp := map[string]string{
-		auth.HeaderPolyBuilderAPIKey:     BuilderKey,
-		auth.HeaderPolyBuilderPassphrase: BuilderPassphrase,
-		auth.HeaderPolyBuilderTimestamp:  fmt.Sprintf("%d", req.Timestamp),
-		auth.HeaderPolyBuilderSignature:  sig,
+// openAuditLog opens AUDIT_LOG for appending, or uses stdout when unset.
+func openAuditLog() (*auditLog, func(), error) {
+	path := os.Getenv("AUDIT_LOG")
+	if path == "" {
+		return newAuditLog(os.Stdout), func() {}, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, nil, err
 	}
+	return newAuditLog(f), func() { f.Close() }, nil
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	_ = json.NewEncoder(w).Encode(resp)
+func handleHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("OK"))
 }
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// metrics collects request counters and latencies and serves them in the
+// Prometheus text exposition format.
+type metrics struct {
+	mu        sync.Mutex
+	requests  map[requestKey]uint64
+	latencies map[string]*latency
+}
+
+type requestKey struct {
+	endpoint, client, decision string
+}
+
+type latency struct {
+	sum   float64
+	count uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:  make(map[requestKey]uint64),
+		latencies: make(map[string]*latency),
+	}
+}
+
+// observe records one request. It is safe to call on a nil receiver.
+func (m *metrics) observe(endpoint, client, decision string, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[requestKey{endpoint, client, decision}]++
+	l := m.latencies[endpoint]
+	if l == nil {
+		l = &latency{}
+		m.latencies[endpoint] = l
+	}
+	l.sum += elapsed.Seconds()
+	l.count++
+}
+
+func (m *metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+	b.WriteString("# HELP signer_requests_total Signing requests by endpoint, client and decision.\n")
+	b.WriteString("# TYPE signer_requests_total counter\n")
+	keys := make([]requestKey, 0, len(m.requests))
+	for k := range m.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+	})
+	for _, k := range keys {
+		fmt.Fprintf(&b, "signer_requests_total{endpoint=%q,client=%q,decision=%q} %d\n", k.endpoint, k.client, k.decision, m.requests[k])
+	}
+
+	b.WriteString("# HELP signer_request_duration_seconds Signing request latency.\n")
+	b.WriteString("# TYPE signer_request_duration_seconds summary\n")
+	endpoints := make([]string, 0, len(m.latencies))
+	for e := range m.latencies {
+		endpoints = append(endpoints, e)
+	}
+	sort.Strings(endpoints)
+	for _, e := range endpoints {
+		l := m.latencies[e]
+		fmt.Fprintf(&b, "signer_request_duration_seconds_sum{endpoint=%q} %g\n", e, l.sum)
+		fmt.Fprintf(&b, "signer_request_duration_seconds_count{endpoint=%q} %d\n", e, l.count)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	_, _ = w.Write([]byte(b.String()))
+}
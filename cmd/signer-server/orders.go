@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const maxSignBodyBytes = 1 << 20
+
+// AuditEntry is one line of the signing audit log.
+type AuditEntry struct {
+	Time        time.Time `json:"time"`
+	Endpoint    string    `json:"endpoint"`
+	Client      string    `json:"client,omitempty"`
+	RemoteAddr  string    `json:"remoteAddr"`
+	Signer      string    `json:"signer"`
+	Method      string    `json:"method,omitempty"`
+	Path        string    `json:"path,omitempty"`
+	PrimaryType string    `json:"primaryType,omitempty"`
+	TokenID     string    `json:"tokenId,omitempty"`
+	Side        string    `json:"side,omitempty"`
+	NotionalUSD string    `json:"notionalUsdc,omitempty"`
+	Hash        string    `json:"hash,omitempty"`
+	Decision    string    `json:"decision"`
+	Reason      string    `json:"reason,omitempty"`
+}
+
+// auditLog writes JSON lines to w.
+type auditLog struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func newAuditLog(w io.Writer) *auditLog {
+	return &auditLog{enc: json.NewEncoder(w)}
+}
+
+func (a *auditLog) Write(e AuditEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.enc.Encode(e); err != nil {
+		log.Printf("audit log write failed: %v", err)
+	}
+}
+
+// keySigner is the signer held by the service.
+type keySigner interface {
+	auth.Signer
+	SignDigest([]byte) ([]byte, error)
+}
+
+// orderService signs orders and digests on behalf of remote clients. When
+// clients are configured every request must authenticate as one of them;
+// otherwise mutual TLS is the only gate.
+type orderService struct {
+	signer  keySigner
+	policy  *Policy
+	clients *clientAuth
+	audit   *auditLog
+	metrics *metrics
+}
+
+func (s *orderService) register(mux *http.ServeMux) {
+	mux.HandleFunc(auth.RemoteSignerInfoPath, s.handleInfo)
+	mux.HandleFunc(auth.RemoteSignTypedDataPath, s.handleSignTypedData)
+	mux.HandleFunc(auth.RemoteSignDigestPath, s.handleSignDigest)
+}
+
+func (s *orderService) handleInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, status, reason := s.clients.admit(r, nil, true); status != 0 {
+		http.Error(w, reason, status)
+		return
+	}
+	writeJSON(w, auth.RemoteSignerInfo{Address: s.signer.Address(), ChainID: s.signer.ChainID().Int64()})
+}
+
+func (s *orderService) handleSignTypedData(w http.ResponseWriter, r *http.Request) {
+	entry := s.newEntry(r)
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, ok := s.admit(w, r, &entry)
+	if !ok {
+		return
+	}
+	var req auth.RemoteSignTypedDataRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		s.reject(w, entry, http.StatusBadRequest, "invalid body")
+		return
+	}
+	td := req.TypedData
+	entry.PrimaryType = td.PrimaryType
+	hash, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		s.reject(w, entry, http.StatusBadRequest, "invalid typed data")
+		return
+	}
+	entry.Hash = hexutil.Encode(hash)
+
+	order, release, err := s.policy.CheckTypedData(td)
+	if order != nil {
+		entry.TokenID, entry.Side, entry.NotionalUSD = order.TokenID, order.Side, order.Notional.String()
+	}
+	if err != nil {
+		s.reject(w, entry, http.StatusForbidden, err.Error())
+		return
+	}
+
+	sig, err := s.signer.SignTypedData(&td.Domain, td.Types, td.Message, td.PrimaryType)
+	if err != nil {
+		release()
+		log.Printf("Signing error: %v", err)
+		s.reject(w, entry, http.StatusInternalServerError, "signing failed")
+		return
+	}
+	s.accept(w, entry, sig)
+}
+
+func (s *orderService) handleSignDigest(w http.ResponseWriter, r *http.Request) {
+	entry := s.newEntry(r)
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, ok := s.admit(w, r, &entry)
+	if !ok {
+		return
+	}
+	var req auth.RemoteSignDigestRequest
+	if err := json.Unmarshal(body, &req); err != nil || len(req.Digest) != 32 {
+		s.reject(w, entry, http.StatusBadRequest, "invalid body")
+		return
+	}
+	entry.Hash = hexutil.Encode(req.Digest)
+	if err := s.policy.CheckDigest(); err != nil {
+		s.reject(w, entry, http.StatusForbidden, err.Error())
+		return
+	}
+	sig, err := s.signer.SignDigest(req.Digest)
+	if err != nil {
+		log.Printf("Signing error: %v", err)
+		s.reject(w, entry, http.StatusInternalServerError, "signing failed")
+		return
+	}
+	s.accept(w, entry, sig)
+}
+
+func (s *orderService) newEntry(r *http.Request) AuditEntry {
+	entry := AuditEntry{
+		Time:       time.Now().UTC(),
+		Endpoint:   r.URL.Path,
+		RemoteAddr: r.RemoteAddr,
+		Signer:     s.signer.Address().Hex(),
+	}
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		entry.Client = r.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	return entry
+}
+
+// admit reads the request body and authenticates the caller, recording the
+// client ID in entry. It rejects the request and returns false on failure.
+func (s *orderService) admit(w http.ResponseWriter, r *http.Request, entry *AuditEntry) ([]byte, bool) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxSignBodyBytes))
+	if err != nil {
+		s.reject(w, *entry, http.StatusBadRequest, "invalid body")
+		return nil, false
+	}
+	client, status, reason := s.clients.admit(r, body, true)
+	if client != nil {
+		entry.Client = client.id
+	}
+	if status != 0 {
+		s.reject(w, *entry, status, reason)
+		return nil, false
+	}
+	return body, true
+}
+
+func (s *orderService) accept(w http.ResponseWriter, entry AuditEntry, sig []byte) {
+	entry.Decision = "signed"
+	s.audit.Write(entry)
+	s.metrics.observe(entry.Endpoint, entry.Client, entry.Decision, time.Since(entry.Time))
+	writeJSON(w, auth.RemoteSignResponse{Signature: sig})
+}
+
+func (s *orderService) reject(w http.ResponseWriter, entry AuditEntry, status int, reason string) {
+	entry.Decision = "denied"
+	if status >= http.StatusInternalServerError {
+		entry.Decision = "error"
+	}
+	entry.Reason = reason
+	s.audit.Write(entry)
+	s.metrics.observe(entry.Endpoint, entry.Client, entry.Decision, time.Since(entry.Time))
+	http.Error(w, reason, status)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+)
+
+var orderTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"Order": {
+		{Name: "salt", Type: "uint256"},
+		{Name: "maker", Type: "address"},
+		{Name: "signer", Type: "address"},
+		{Name: "tokenId", Type: "uint256"},
+		{Name: "makerAmount", Type: "uint256"},
+		{Name: "takerAmount", Type: "uint256"},
+		{Name: "side", Type: "uint8"},
+		{Name: "signatureType", Type: "uint8"},
+		{Name: "timestamp", Type: "uint256"},
+		{Name: "metadata", Type: "bytes32"},
+		{Name: "builder", Type: "bytes32"},
+	},
+}
+
+func orderMessage(signer auth.Signer, tokenID int64, side int64, makerAmount, takerAmount int64) apitypes.TypedDataMessage {
+	hex := func(v int64) *math.HexOrDecimal256 { return (*math.HexOrDecimal256)(big.NewInt(v)) }
+	zero := "0x" + strings.Repeat("0", 64)
+	return apitypes.TypedDataMessage{
+		"salt":          hex(1),
+		"maker":         signer.Address().String(),
+		"signer":        signer.Address().String(),
+		"tokenId":       hex(tokenID),
+		"makerAmount":   hex(makerAmount),
+		"takerAmount":   hex(takerAmount),
+		"side":          hex(side),
+		"signatureType": hex(0),
+		"timestamp":     hex(1700000000),
+		"metadata":      zero,
+		"builder":       zero,
+	}
+}
+
+func TestOrderServiceEnforcesPolicy(t *testing.T) {
+	key, err := auth.NewMnemonicSigner("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", "", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	policy := &Policy{
+		AllowedTokens: map[string]bool{"42": true},
+		AllowedSides:  map[string]bool{"BUY": true, "SELL": true},
+		MaxOrderUSDC:  decimal.NewFromInt(50),
+		MaxDailyUSDC:  decimal.NewFromInt(60),
+		ChainID:       137,
+		now:           func() time.Time { return time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC) },
+	}
+	var audit bytes.Buffer
+	mux := http.NewServeMux()
+	(&orderService{signer: key, policy: policy, clients: &clientAuth{}, audit: newAuditLog(&audit)}).register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	remote, err := auth.NewRemoteSigner(context.Background(), auth.RemoteSignerConfig{URL: srv.URL})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	domain := &apitypes.TypedDataDomain{
+		Name:              "Polymarket CTF Exchange",
+		Version:           "2",
+		ChainId:           (*math.HexOrDecimal256)(big.NewInt(137)),
+		VerifyingContract: "0xE111180000d2663C0091e4f400237545B87B996B",
+	}
+
+	// Buy 40 USDC of token 42: signed and identical to a local signature.
+	msg := orderMessage(key, 42, 0, 40_000_000, 80_000_000)
+	sig, err := remote.SignTypedData(domain, orderTypes, msg, "Order")
+	if err != nil {
+		t.Fatalf("sign allowed order: %v", err)
+	}
+	want, _ := key.SignTypedData(domain, orderTypes, msg, "Order")
+	if !bytes.Equal(sig, want) {
+		t.Fatal("remote order signature differs from local signature")
+	}
+
+	denied := []struct {
+		name string
+		msg  apitypes.TypedDataMessage
+	}{
+		{"token", orderMessage(key, 7, 0, 1_000_000, 2_000_000)},
+		{"per-order notional", orderMessage(key, 42, 1, 100_000_000, 55_000_000)},
+		{"daily notional", orderMessage(key, 42, 1, 50_000_000, 25_000_000)},
+	}
+	for _, tc := range denied {
+		if _, err := remote.SignTypedData(domain, orderTypes, tc.msg, "Order"); err == nil || !strings.Contains(err.Error(), "403") {
+			t.Fatalf("%s: expected policy rejection, got %v", tc.name, err)
+		}
+	}
+	if _, err := remote.SignDigest(crypto.Keccak256([]byte("x"))); err == nil {
+		t.Fatal("expected digest signing to be disabled")
+	}
+
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 audit entries, got %d", len(lines))
+	}
+	var first, daily AuditEntry
+	json.Unmarshal([]byte(lines[0]), &first)
+	json.Unmarshal([]byte(lines[3]), &daily)
+	if first.Decision != "signed" || first.TokenID != "42" || first.Side != "BUY" || first.NotionalUSD != "40" {
+		t.Fatalf("unexpected audit entry %+v", first)
+	}
+	if daily.Decision != "denied" || !strings.Contains(daily.Reason, "daily") {
+		t.Fatalf("unexpected audit entry %+v", daily)
+	}
+}
+
+func TestPolicyChecksDomainAndTypes(t *testing.T) {
+	key, err := auth.NewMnemonicSigner("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", "", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	policy := &Policy{AllowedSides: map[string]bool{"BUY": true, "SELL": true}, ChainID: 137}
+	order := func() apitypes.TypedData {
+		return apitypes.TypedData{
+			Types:       clob.OrderTypes(),
+			PrimaryType: "Order",
+			Domain: apitypes.TypedDataDomain{
+				Name:              clob.ExchangeDomainName,
+				Version:           clob.ExchangeDomainVersion,
+				ChainId:           (*math.HexOrDecimal256)(big.NewInt(137)),
+				VerifyingContract: clob.ExchangeV2Address,
+			},
+			Message: orderMessage(key, 42, 0, 1_000_000, 2_000_000),
+		}
+	}
+	if _, _, err := policy.CheckTypedData(order()); err != nil {
+		t.Fatalf("canonical order denied: %v", err)
+	}
+
+	wrongContract := order()
+	wrongContract.Domain.VerifyingContract = "0x000000000000000000000000000000000000dEaD"
+	tamperedType := order()
+	tamperedType.Types["Order"][4] = apitypes.Type{Name: "makerAmount", Type: "uint128"}
+	droppedDomainField := order()
+	droppedDomainField.Types["EIP712Domain"] = droppedDomainField.Types["EIP712Domain"][:3]
+	wrongName := order()
+	wrongName.Domain.Name = "Other Exchange"
+	for name, td := range map[string]apitypes.TypedData{
+		"verifying contract": wrongContract,
+		"tampered type":      tamperedType,
+		"domain type":        droppedDomainField,
+		"domain name":        wrongName,
+	} {
+		if _, _, err := policy.CheckTypedData(td); err == nil {
+			t.Fatalf("%s: expected policy rejection", name)
+		}
+	}
+
+	policy.AllowedExchanges = map[common.Address]bool{common.HexToAddress(wrongContract.Domain.VerifyingContract): true}
+	if _, _, err := policy.CheckTypedData(wrongContract); err != nil {
+		t.Fatalf("configured exchange denied: %v", err)
+	}
+
+	clobAuth := apitypes.TypedData{
+		Types:       auth.ClobAuthTypes,
+		PrimaryType: "ClobAuth",
+		Domain: apitypes.TypedDataDomain{
+			Name:    auth.ClobAuthDomain.Name,
+			Version: auth.ClobAuthDomain.Version,
+			ChainId: (*math.HexOrDecimal256)(big.NewInt(137)),
+		},
+	}
+	if _, _, err := policy.CheckTypedData(clobAuth); err == nil {
+		t.Fatal("expected ClobAuth to be disabled by default")
+	}
+	policy.AllowClobAuth = true
+	if _, _, err := policy.CheckTypedData(clobAuth); err != nil {
+		t.Fatalf("ClobAuth denied: %v", err)
+	}
+	clobAuth.Types = apitypes.Types{
+		"EIP712Domain": auth.ClobAuthTypes["EIP712Domain"],
+		"ClobAuth":     auth.ClobAuthTypes["ClobAuth"][:3],
+	}
+	if _, _, err := policy.CheckTypedData(clobAuth); err == nil {
+		t.Fatal("expected tampered ClobAuth type to be denied")
+	}
+}
+
+func TestOrderServiceAuthenticatesClients(t *testing.T) {
+	key, err := auth.NewMnemonicSigner("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "", "", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	clients := &clientAuth{
+		clients: map[string]*signerClient{"bot": {id: "bot", token: "tok", limiter: transport.NewRateLimiter(100)}},
+		maxSkew: defaultMaxClockSkew,
+		now:     time.Now,
+	}
+	var audit bytes.Buffer
+	mux := http.NewServeMux()
+	(&orderService{
+		signer:  key,
+		policy:  &Policy{ChainID: 137, AllowDigest: true},
+		clients: clients,
+		audit:   newAuditLog(&audit),
+	}).register(mux)
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	ctx := context.Background()
+
+	for _, token := range []string{"", "wrong"} {
+		_, err := auth.NewRemoteSigner(ctx, auth.RemoteSignerConfig{URL: srv.URL, Token: token})
+		if !errors.Is(err, sdkerrors.ErrUnauthorized) {
+			t.Fatalf("token %q: expected ErrUnauthorized, got %v", token, err)
+		}
+	}
+	remote, err := auth.NewRemoteSigner(ctx, auth.RemoteSignerConfig{URL: srv.URL, Address: key.Address(), ChainID: 137})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	if _, err := remote.SignDigest(crypto.Keccak256([]byte("x"))); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthenticated digest to be rejected, got %v", err)
+	}
+
+	remote, err = auth.NewRemoteSigner(ctx, auth.RemoteSignerConfig{URL: srv.URL, Token: "tok"})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	if _, err := remote.SignDigest(crypto.Keccak256([]byte("x"))); err != nil {
+		t.Fatalf("sign digest: %v", err)
+	}
+	var last AuditEntry
+	lines := strings.Split(strings.TrimSpace(audit.String()), "\n")
+	json.Unmarshal([]byte(lines[len(lines)-1]), &last)
+	if last.Decision != "signed" || last.Client != "bot" {
+		t.Fatalf("unexpected audit entry %+v", last)
+	}
+}
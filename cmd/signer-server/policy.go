@@ -0,0 +1,298 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethmath "github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+)
+
+// collateralDecimals is the number of decimals of the USDC collateral token.
+const collateralDecimals = 6
+
+// Policy restricts which orders the service signs. Zero limits are unlimited
+// and an empty token list allows every token. An empty exchange list allows
+// only the exchange the SDK signs orders for.
+type Policy struct {
+	AllowedExchanges map[common.Address]bool
+	AllowedTokens    map[string]bool
+	AllowedSides     map[string]bool
+	MaxOrderUSDC     decimal.Decimal
+	MaxDailyUSDC     decimal.Decimal
+	AllowClobAuth    bool
+	AllowDigest      bool
+	ChainID          int64
+	now              func() time.Time
+	mu               sync.Mutex
+	day              string
+	dailyNotional    decimal.Decimal
+}
+
+// OrderSummary holds the policy-relevant fields of an order.
+type OrderSummary struct {
+	TokenID  string
+	Side     string
+	Notional decimal.Decimal
+}
+
+// PolicyError is returned when a request is rejected by the policy.
+type PolicyError struct {
+	Reason string
+}
+
+func (e *PolicyError) Error() string { return "policy: " + e.Reason }
+
+func deny(format string, args ...any) error {
+	return &PolicyError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// policyFromEnv builds the policy from POLICY_* environment variables.
+func policyFromEnv(chainID int64) (*Policy, error) {
+	p := &Policy{
+		AllowedTokens: splitSet(os.Getenv("POLICY_ALLOWED_TOKENS"), false),
+		AllowedSides:  splitSet(os.Getenv("POLICY_ALLOWED_SIDES"), true),
+		AllowClobAuth: strings.EqualFold(os.Getenv("POLICY_ALLOW_CLOB_AUTH"), "true"),
+		AllowDigest:   strings.EqualFold(os.Getenv("POLICY_ALLOW_DIGEST"), "true"),
+		ChainID:       chainID,
+	}
+	if len(p.AllowedSides) == 0 {
+		p.AllowedSides = map[string]bool{"BUY": true, "SELL": true}
+	}
+	exchanges, err := parseExchanges(os.Getenv("POLICY_ALLOWED_EXCHANGES"))
+	if err != nil {
+		return nil, err
+	}
+	p.AllowedExchanges = exchanges
+	if p.MaxOrderUSDC, err = parseLimit("POLICY_MAX_ORDER_USDC"); err != nil {
+		return nil, err
+	}
+	if p.MaxDailyUSDC, err = parseLimit("POLICY_MAX_DAILY_USDC"); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func parseLimit(name string) (decimal.Decimal, error) {
+	raw := strings.TrimSpace(os.Getenv(name))
+	if raw == "" {
+		return decimal.Zero, nil
+	}
+	v, err := decimal.NewFromString(raw)
+	if err != nil || v.IsNegative() {
+		return decimal.Zero, fmt.Errorf("invalid %s %q", name, raw)
+	}
+	return v, nil
+}
+
+func parseExchanges(raw string) (map[common.Address]bool, error) {
+	set := make(map[common.Address]bool)
+	for item := range splitSet(raw, false) {
+		if !common.IsHexAddress(item) {
+			return nil, fmt.Errorf("invalid POLICY_ALLOWED_EXCHANGES address %q", item)
+		}
+		set[common.HexToAddress(item)] = true
+	}
+	return set, nil
+}
+
+func splitSet(raw string, upper bool) map[string]bool {
+	set := make(map[string]bool)
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if upper {
+			item = strings.ToUpper(item)
+		}
+		if item != "" {
+			set[item] = true
+		}
+	}
+	return set
+}
+
+// CheckTypedData validates a typed-data signing request. The domain and types
+// must match what the SDK signs exactly, so a caller cannot have an order
+// signed for another contract or under a different struct hash. For orders it
+// reserves the order's notional against the daily limit; the returned release
+// function gives it back if signing fails.
+func (p *Policy) CheckTypedData(td apitypes.TypedData) (*OrderSummary, func(), error) {
+	if td.Domain.ChainId == nil || (*big.Int)(td.Domain.ChainId).Cmp(big.NewInt(p.ChainID)) != 0 {
+		return nil, nil, deny("domain chain ID does not match %d", p.ChainID)
+	}
+	if td.Domain.Salt != "" {
+		return nil, nil, deny("domain salt not allowed")
+	}
+	switch td.PrimaryType {
+	case "ClobAuth":
+		if !p.AllowClobAuth {
+			return nil, nil, deny("ClobAuth signing disabled")
+		}
+		if td.Domain.Name != auth.ClobAuthDomain.Name || td.Domain.Version != auth.ClobAuthDomain.Version || td.Domain.VerifyingContract != "" {
+			return nil, nil, deny("domain is not the ClobAuth domain")
+		}
+		if !typesEqual(td.Types, auth.ClobAuthTypes) {
+			return nil, nil, deny("ClobAuth types do not match")
+		}
+		return nil, func() {}, nil
+	case "Order":
+	default:
+		return nil, nil, deny("primary type %q not allowed", td.PrimaryType)
+	}
+
+	if td.Domain.Name != clob.ExchangeDomainName || td.Domain.Version != clob.ExchangeDomainVersion {
+		return nil, nil, deny("domain %q version %q is not an exchange domain", td.Domain.Name, td.Domain.Version)
+	}
+	if !p.exchangeAllowed(td.Domain.VerifyingContract) {
+		return nil, nil, deny("verifying contract %s not allowed", td.Domain.VerifyingContract)
+	}
+	if !typesEqual(td.Types, clob.OrderTypes()) {
+		return nil, nil, deny("Order types do not match")
+	}
+
+	order, err := summarizeOrder(td.Message)
+	if err != nil {
+		return nil, nil, deny("%v", err)
+	}
+	if len(p.AllowedTokens) > 0 && !p.AllowedTokens[order.TokenID] {
+		return order, nil, deny("token %s not allowed", order.TokenID)
+	}
+	if !p.AllowedSides[order.Side] {
+		return order, nil, deny("side %s not allowed", order.Side)
+	}
+	if p.MaxOrderUSDC.IsPositive() && order.Notional.GreaterThan(p.MaxOrderUSDC) {
+		return order, nil, deny("order notional %s exceeds %s", order.Notional, p.MaxOrderUSDC)
+	}
+	release, err := p.reserve(order.Notional)
+	if err != nil {
+		return order, nil, err
+	}
+	return order, release, nil
+}
+
+// CheckDigest validates a raw digest signing request. Digests cannot be
+// inspected, so they are only signed when explicitly enabled.
+func (p *Policy) CheckDigest() error {
+	if !p.AllowDigest {
+		return deny("digest signing disabled")
+	}
+	return nil
+}
+
+func (p *Policy) exchangeAllowed(contract string) bool {
+	if !common.IsHexAddress(contract) {
+		return false
+	}
+	address := common.HexToAddress(contract)
+	if len(p.AllowedExchanges) == 0 {
+		return address == common.HexToAddress(clob.ExchangeV2Address)
+	}
+	return p.AllowedExchanges[address]
+}
+
+// typesEqual reports whether got defines exactly the structs in want, with
+// the same fields in the same order.
+func typesEqual(got, want apitypes.Types) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for name, fields := range want {
+		if !slices.Equal(got[name], fields) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Policy) reserve(notional decimal.Decimal) (func(), error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	day := p.today()
+	if day != p.day {
+		p.day, p.dailyNotional = day, decimal.Zero
+	}
+	next := p.dailyNotional.Add(notional)
+	if p.MaxDailyUSDC.IsPositive() && next.GreaterThan(p.MaxDailyUSDC) {
+		return nil, deny("daily notional %s would exceed %s", next, p.MaxDailyUSDC)
+	}
+	p.dailyNotional = next
+	return func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.day == day {
+			p.dailyNotional = p.dailyNotional.Sub(notional)
+		}
+	}, nil
+}
+
+func (p *Policy) today() string {
+	now := time.Now
+	if p.now != nil {
+		now = p.now
+	}
+	return now().UTC().Format(time.DateOnly)
+}
+
+// summarizeOrder extracts token, side and USDC notional from an Order
+// message. Buy orders spend makerAmount of collateral; sell orders receive
+// takerAmount.
+func summarizeOrder(msg apitypes.TypedDataMessage) (*OrderSummary, error) {
+	tokenID, err := messageInt(msg, "tokenId")
+	if err != nil {
+		return nil, err
+	}
+	side, err := messageInt(msg, "side")
+	if err != nil {
+		return nil, err
+	}
+	summary := &OrderSummary{TokenID: tokenID.String()}
+	var amount *big.Int
+	switch side.Int64() {
+	case 0:
+		summary.Side = "BUY"
+		amount, err = messageInt(msg, "makerAmount")
+	case 1:
+		summary.Side = "SELL"
+		amount, err = messageInt(msg, "takerAmount")
+	default:
+		return nil, fmt.Errorf("invalid side %s", side)
+	}
+	if err != nil {
+		return nil, err
+	}
+	summary.Notional = decimal.NewFromBigInt(amount, -collateralDecimals)
+	return summary, nil
+}
+
+func messageInt(msg apitypes.TypedDataMessage, field string) (*big.Int, error) {
+	switch v := msg[field].(type) {
+	case string:
+		if n, ok := gethmath.ParseBig256(v); ok {
+			return n, nil
+		}
+	case json.Number:
+		if n, ok := gethmath.ParseBig256(v.String()); ok {
+			return n, nil
+		}
+	case float64:
+		if v >= 0 && v == math.Trunc(v) && v < 1<<53 {
+			return big.NewInt(int64(v)), nil
+		}
+	case *gethmath.HexOrDecimal256:
+		if v != nil {
+			return (*big.Int)(v), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid %s", field)
+}
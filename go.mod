@@ -9,6 +9,7 @@ require (
 	github.com/ethereum/go-ethereum v1.17.3
 	github.com/gorilla/websocket v1.5.3
 	github.com/shopspring/decimal v1.4.0
+	github.com/tyler-smith/go-bip39 v1.0.2
 	go.uber.org/goleak v1.3.0
 )
 
@@ -1,6 +1,8 @@
 package polymarket
 
 import (
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/bridge"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
@@ -95,6 +97,24 @@ func WithCTF(client ctf.Client) Option {
 	}
 }
 
+type readonlyAuth struct {
+	address common.Address
+	apiKey  *auth.APIKey
+}
+
+// WithReadonlyAuth builds Client.CLOBReadonly, a query-only CLOB client
+// authenticated with a readonly API key of address (see
+// clob.Client.CreateReadonlyAPIKey). No private key is needed. NewClient
+// makes no network call for it, so the key is not checked with the exchange;
+// use clob.NewReadonlyClientWithKey to reject keys that could trade. A
+// missing address or key leaves CLOBReadonly nil and is recorded in
+// InitErrors. The CLOB field stays unauthenticated.
+func WithReadonlyAuth(address common.Address, apiKey *auth.APIKey) Option {
+	return func(c *Client) {
+		c.readonlyAuth = &readonlyAuth{address: address, apiKey: apiKey}
+	}
+}
+
 // WithBuilderConfig configures builder attribution using either local or remote signing.
 func WithBuilderConfig(cfg *auth.BuilderConfig) Option {
 	return func(c *Client) {
@@ -16,6 +16,7 @@ import (
 	"math/big"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
@@ -124,6 +125,7 @@ var (
 	ErrMissingBuilderConfig   = sdkerrors.ErrMissingBuilderConfig
 	ErrProxyWalletUnsupported = sdkerrors.ErrProxyWalletUnsupported
 	ErrSafeWalletUnsupported  = sdkerrors.ErrSafeWalletUnsupported
+	ErrSignerClosed           = sdkerrors.ErrSignerClosed
 )
 
 // Authentication header keys used by Polymarket API.
@@ -142,6 +144,7 @@ const (
 
 // PrivateKeySigner implements the Signer interface using a local ECDSA private key.
 type PrivateKeySigner struct {
+	mu      sync.RWMutex
 	key     *ecdsa.PrivateKey
 	address common.Address
 	chainID *big.Int
@@ -295,6 +298,10 @@ type BuilderRemoteConfig struct {
 	Host string
 	// Token is an optional bearer token for authenticating with the signer.
 	Token string
+	// ClientID and ClientSecret authenticate with the signer using a shared
+	// HMAC secret; see SignRemoteRequest. ClientSecret is base64-encoded.
+	ClientID     string
+	ClientSecret string
 	// HTTPClient allows providing a custom client for signing requests.
 	HTTPClient BuilderHTTPDoer
 }
@@ -389,6 +396,16 @@ func buildBuilderHeadersRemote(ctx context.Context, remote *BuilderRemoteConfig,
 	if remote.Token != "" {
 		req.Header.Set("Authorization", "Bearer "+remote.Token)
 	}
+	if remote.ClientSecret != "" {
+		now := time.Now().Unix()
+		sig, err := SignRemoteRequest(remote.ClientSecret, now, http.MethodPost, req.URL.Path, raw)
+		if err != nil {
+			return nil, fmt.Errorf("sign builder request: %w", err)
+		}
+		req.Header.Set(HeaderSignerClientID, remote.ClientID)
+		req.Header.Set(HeaderSignerTimestamp, fmt.Sprintf("%d", now))
+		req.Header.Set(HeaderSignerSignature, sig)
+	}
 
 	client := remote.HTTPClient
 	if client == nil {
@@ -400,6 +417,9 @@ func buildBuilderHeadersRemote(ctx context.Context, remote *BuilderRemoteConfig,
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("%w: builder signer rejected credentials", sdkerrors.ErrUnauthorized)
+	}
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return nil, fmt.Errorf("builder signer error: status %d", resp.StatusCode)
 	}
@@ -509,6 +529,11 @@ func (s *PrivateKeySigner) SignTypedData(domain *apitypes.TypedDataDomain, types
 		return nil, fmt.Errorf("failed to hash typed data: %w", err)
 	}
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.key == nil {
+		return nil, ErrSignerClosed
+	}
 	signature, err := crypto.Sign(sighash, s.key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign hash: %w", err)
@@ -526,6 +551,11 @@ func (s *PrivateKeySigner) SignDigest(digest []byte) ([]byte, error) {
 	if len(digest) != 32 {
 		return nil, fmt.Errorf("digest must be 32 bytes, got %d", len(digest))
 	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.key == nil {
+		return nil, ErrSignerClosed
+	}
 	signature, err := crypto.Sign(digest, s.key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign hash: %w", err)
@@ -535,3 +565,48 @@ func (s *PrivateKeySigner) SignDigest(digest []byte) ([]byte, error) {
 	}
 	return signature, nil
 }
+
+// Close wipes the private key from memory. Signing afterwards fails with
+// ErrSignerClosed; the address remains available.
+func (s *PrivateKeySigner) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.key != nil {
+		zeroKey(s.key)
+		s.key = nil
+	}
+	return nil
+}
+
+func zeroKey(key *ecdsa.PrivateKey) {
+	if key == nil || key.D == nil {
+		return
+	}
+	clear(key.D.Bits())
+	key.D.SetInt64(0)
+}
+
+// WatchOnlySigner is a Signer that knows an address but holds no key. It is
+// used to build orders for keys kept offline; signing always fails.
+type WatchOnlySigner struct {
+	address common.Address
+	chainID *big.Int
+}
+
+// NewWatchOnlySigner creates a watch-only signer for address.
+func NewWatchOnlySigner(address common.Address, chainID int64) *WatchOnlySigner {
+	return &WatchOnlySigner{address: address, chainID: big.NewInt(chainID)}
+}
+
+func (s *WatchOnlySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *WatchOnlySigner) ChainID() *big.Int {
+	return s.chainID
+}
+
+// SignTypedData always fails.
+func (s *WatchOnlySigner) SignTypedData(*apitypes.TypedDataDomain, apitypes.Types, apitypes.TypedDataMessage, string) ([]byte, error) {
+	return nil, fmt.Errorf("%w: watch-only signer for %s cannot sign", ErrMissingSigner, s.address.Hex())
+}
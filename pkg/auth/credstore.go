@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/internal/atomicfile"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StoredCredentials are L2 API credentials persisted by a CredentialStore.
+type StoredCredentials struct {
+	APIKey APIKey `json:"apiKey"`
+	// Nonce is the L1 nonce the key was created or derived with.
+	Nonce     int64     `json:"nonce"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CredentialStore persists L2 API credentials per signer address.
+type CredentialStore interface {
+	// LoadCredentials returns the stored credentials for address, or nil
+	// when there are none.
+	LoadCredentials(address common.Address) (*StoredCredentials, error)
+	// SaveCredentials stores creds for address, replacing existing ones.
+	SaveCredentials(address common.Address, creds *StoredCredentials) error
+}
+
+// FileCredentialStore keeps credentials in a single file encrypted with a
+// passphrase, using the scrypt and AES scheme of go-ethereum keystores.
+type FileCredentialStore struct {
+	path       string
+	passphrase PassphraseFunc
+	scryptN    int
+	scryptP    int
+
+	mu sync.Mutex
+}
+
+// NewFileCredentialStore returns a store backed by the file at path. The file
+// is created on the first save.
+func NewFileCredentialStore(path string, passphrase PassphraseFunc) *FileCredentialStore {
+	return &FileCredentialStore{
+		path:       path,
+		passphrase: passphrase,
+		scryptN:    keystore.StandardScryptN,
+		scryptP:    keystore.StandardScryptP,
+	}
+}
+
+// LoadCredentials implements CredentialStore.
+func (s *FileCredentialStore) LoadCredentials(address common.Address) (*StoredCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := all[strings.ToLower(address.Hex())]
+	if !ok {
+		return nil, nil
+	}
+	return &creds, nil
+}
+
+// SaveCredentials implements CredentialStore.
+func (s *FileCredentialStore) SaveCredentials(address common.Address, creds *StoredCredentials) error {
+	if creds == nil {
+		return errors.New("credentials are required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	if all == nil {
+		all = make(map[string]StoredCredentials)
+	}
+	all[strings.ToLower(address.Hex())] = *creds
+	return s.write(all)
+}
+
+func (s *FileCredentialStore) read() (map[string]StoredCredentials, error) {
+	raw, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read credential store: %w", err)
+	}
+	var encrypted keystore.CryptoJSON
+	if err := json.Unmarshal(raw, &encrypted); err != nil {
+		return nil, fmt.Errorf("decode credential store: %w", err)
+	}
+	pass, err := s.pass()
+	if err != nil {
+		return nil, err
+	}
+	defer clear(pass)
+	plain, err := keystore.DecryptDataV3(encrypted, string(pass))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt credential store: %w", err)
+	}
+	defer clear(plain)
+	var all map[string]StoredCredentials
+	if err := json.Unmarshal(plain, &all); err != nil {
+		return nil, fmt.Errorf("decode credential store: %w", err)
+	}
+	return all, nil
+}
+
+func (s *FileCredentialStore) write(all map[string]StoredCredentials) error {
+	plain, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	defer clear(plain)
+	pass, err := s.pass()
+	if err != nil {
+		return err
+	}
+	defer clear(pass)
+	encrypted, err := keystore.EncryptDataV3(plain, pass, s.scryptN, s.scryptP)
+	if err != nil {
+		return fmt.Errorf("encrypt credential store: %w", err)
+	}
+	raw, err := json.Marshal(encrypted)
+	if err != nil {
+		return err
+	}
+
+	// Replace the file atomically so a crash never leaves a truncated
+	// store behind.
+	if err := atomicfile.WriteFile(s.path, raw, 0o600); err != nil {
+		return fmt.Errorf("write credential store: %w", err)
+	}
+	return nil
+}
+
+func (s *FileCredentialStore) pass() ([]byte, error) {
+	if s.passphrase == nil {
+		return nil, errors.New("credential store passphrase source is required")
+	}
+	return s.passphrase()
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestFileCredentialStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.json")
+	passphrase := func() ([]byte, error) { return []byte("hunter2"), nil }
+	store := NewFileCredentialStore(path, passphrase)
+	store.scryptN, store.scryptP = keystore.LightScryptN, keystore.LightScryptP
+
+	addr := common.HexToAddress("0x2c7536E3605D9C16a7a3D7b1898e529396a65c23")
+	if creds, err := store.LoadCredentials(addr); err != nil || creds != nil {
+		t.Fatalf("expected empty store, got %+v, %v", creds, err)
+	}
+	want := &StoredCredentials{APIKey: APIKey{Key: "key", Secret: "very-secret", Passphrase: "pass"}, Nonce: 2}
+	if err := store.SaveCredentials(addr, want); err != nil {
+		t.Fatalf("SaveCredentials: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read store: %v", err)
+	}
+	if strings.Contains(string(raw), "very-secret") {
+		t.Fatal("credential store is not encrypted")
+	}
+	info, _ := os.Stat(path)
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("unexpected permissions %v", info.Mode().Perm())
+	}
+
+	got, err := store.LoadCredentials(addr)
+	if err != nil || got == nil || got.APIKey != want.APIKey || got.Nonce != 2 {
+		t.Fatalf("unexpected credentials %+v, %v", got, err)
+	}
+
+	wrong := NewFileCredentialStore(path, func() ([]byte, error) { return []byte("wrong"), nil })
+	if _, err := wrong.LoadCredentials(addr); err == nil {
+		t.Fatal("expected decryption failure with the wrong passphrase")
+	}
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultHDPath is the BIP-44 derivation path of the first Ethereum account.
+const DefaultHDPath = "m/44'/60'/0'/0/0"
+
+// PassphraseFunc supplies the passphrase of an encrypted key. The returned
+// slice is wiped after use.
+type PassphraseFunc func() ([]byte, error)
+
+// PassphraseFromFile returns a PassphraseFunc reading the passphrase from
+// path. A single trailing newline is ignored.
+func PassphraseFromFile(path string) PassphraseFunc {
+	return func() ([]byte, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read passphrase file: %w", err)
+		}
+		data = bytes.TrimSuffix(data, []byte("\n"))
+		data = bytes.TrimSuffix(data, []byte("\r"))
+		return data, nil
+	}
+}
+
+// NewKeystoreSigner creates a signer from a go-ethereum V3 JSON keystore,
+// decrypted with the passphrase returned by passphrase. Call Close to wipe
+// the key when the signer is no longer needed.
+func NewKeystoreSigner(keyJSON []byte, passphrase PassphraseFunc, chainID int64) (*PrivateKeySigner, error) {
+	if passphrase == nil {
+		return nil, errors.New("keystore passphrase source is required")
+	}
+	pass, err := passphrase()
+	if err != nil {
+		return nil, err
+	}
+	defer clear(pass)
+
+	key, err := keystore.DecryptKey(keyJSON, string(pass))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore: %w", err)
+	}
+	return newPrivateKeySigner(key.PrivateKey, chainID), nil
+}
+
+// NewKeystoreSignerFromFile is NewKeystoreSigner reading the keystore from path.
+func NewKeystoreSignerFromFile(path string, passphrase PassphraseFunc, chainID int64) (*PrivateKeySigner, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keystore: %w", err)
+	}
+	return NewKeystoreSigner(keyJSON, passphrase, chainID)
+}
+
+// NewMnemonicSigner creates a signer from a BIP-39 mnemonic and optional
+// BIP-39 password, deriving the key at the BIP-44 path hdPath. An empty
+// hdPath uses DefaultHDPath. Call Close to wipe the key when the signer is
+// no longer needed.
+func NewMnemonicSigner(mnemonic, password, hdPath string, chainID int64) (*PrivateKeySigner, error) {
+	if hdPath == "" {
+		hdPath = DefaultHDPath
+	}
+	path, err := accounts.ParseDerivationPath(hdPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path: %w", err)
+	}
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %w", err)
+	}
+	defer clear(seed)
+
+	key, err := deriveHDKey(seed, path)
+	if err != nil {
+		return nil, err
+	}
+	return newPrivateKeySigner(key, chainID), nil
+}
+
+// SignerFromEnv loads a signing key from environment variables named with
+// prefix, in order of preference: an encrypted keystore (prefix+"KEYSTORE"
+// with prefix+"KEYSTORE_PASSWORD_FILE"), a mnemonic (prefix+"MNEMONIC_FILE"
+// or prefix+"MNEMONIC", with optional prefix+"MNEMONIC_PASSWORD" and
+// prefix+"HD_PATH") or a raw private key (prefix+"PK"). It returns nil when
+// none is set.
+func SignerFromEnv(prefix string, chainID int64) (*PrivateKeySigner, error) {
+	env := func(name string) string { return strings.TrimSpace(os.Getenv(prefix + name)) }
+
+	if path := env("KEYSTORE"); path != "" {
+		passFile := env("KEYSTORE_PASSWORD_FILE")
+		if passFile == "" {
+			return nil, fmt.Errorf("%sKEYSTORE requires %sKEYSTORE_PASSWORD_FILE", prefix, prefix)
+		}
+		return NewKeystoreSignerFromFile(path, PassphraseFromFile(passFile), chainID)
+	}
+
+	mnemonic := env("MNEMONIC")
+	if path := env("MNEMONIC_FILE"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read mnemonic file: %w", err)
+		}
+		mnemonic = strings.Join(strings.Fields(string(data)), " ")
+	}
+	if mnemonic != "" {
+		return NewMnemonicSigner(mnemonic, os.Getenv(prefix+"MNEMONIC_PASSWORD"), env("HD_PATH"), chainID)
+	}
+
+	if pk := env("PK"); pk != "" {
+		return NewPrivateKeySigner(pk, chainID)
+	}
+	return nil, nil
+}
+
+func newPrivateKeySigner(key *ecdsa.PrivateKey, chainID int64) *PrivateKeySigner {
+	return &PrivateKeySigner{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+		chainID: big.NewInt(chainID),
+	}
+}
+
+// deriveHDKey derives the BIP-32 private key at path from seed.
+func deriveHDKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	defer clear(sum)
+
+	n := crypto.S256().Params().N
+	k := new(big.Int).SetBytes(sum[:32])
+	chain := append([]byte(nil), sum[32:]...)
+	defer clear(chain)
+	if k.Sign() == 0 || k.Cmp(n) >= 0 {
+		return nil, errors.New("invalid master key")
+	}
+
+	data := make([]byte, 37)
+	defer clear(data)
+	for _, index := range path {
+		kb := padded32(k)
+		if index >= 0x80000000 {
+			data[0] = 0
+			copy(data[1:33], kb)
+		} else {
+			priv, err := crypto.ToECDSA(kb)
+			if err != nil {
+				clear(kb)
+				return nil, err
+			}
+			copy(data[:33], crypto.CompressPubkey(&priv.PublicKey))
+			zeroKey(priv)
+		}
+		clear(kb)
+		binary.BigEndian.PutUint32(data[33:], index)
+
+		mac := hmac.New(sha512.New, chain)
+		mac.Write(data)
+		child := mac.Sum(nil)
+		il := new(big.Int).SetBytes(child[:32])
+		if il.Cmp(n) >= 0 {
+			clear(child)
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		next := new(big.Int).Add(k, il)
+		next.Mod(next, n)
+		clear(k.Bits())
+		clear(il.Bits())
+		k = next
+		copy(chain, child[32:])
+		clear(child)
+		if k.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+	}
+
+	kb := padded32(k)
+	defer clear(kb)
+	clear(k.Bits())
+	return crypto.ToECDSA(kb)
+}
+
+// padded32 returns v as a 32-byte big-endian slice.
+func padded32(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, 32))
+}
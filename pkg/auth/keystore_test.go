@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestKeystoreSignerRoundTrip(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Address:    crypto.PubkeyToAddress(key.PublicKey),
+		PrivateKey: key,
+	}, "hunter2", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("encrypt key: %v", err)
+	}
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.json")
+	passPath := filepath.Join(dir, "pass")
+	os.WriteFile(keyPath, keyJSON, 0o600)
+	os.WriteFile(passPath, []byte("hunter2\n"), 0o600)
+
+	signer, err := NewKeystoreSignerFromFile(keyPath, PassphraseFromFile(passPath), 137)
+	if err != nil {
+		t.Fatalf("NewKeystoreSignerFromFile: %v", err)
+	}
+	if signer.Address() != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("unexpected address %s", signer.Address().Hex())
+	}
+
+	digest := crypto.Keccak256([]byte("poly"))
+	sig, err := signer.SignDigest(digest)
+	if err != nil {
+		t.Fatalf("SignDigest: %v", err)
+	}
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil || crypto.PubkeyToAddress(*pub) != signer.Address() {
+		t.Fatalf("signature does not recover signer: %v", err)
+	}
+
+	wrong := func() ([]byte, error) { return []byte("wrong"), nil }
+	if _, err := NewKeystoreSigner(keyJSON, wrong, 137); err == nil {
+		t.Fatal("expected error for wrong passphrase")
+	}
+}
+
+func TestMnemonicSignerDerivesBIP44Accounts(t *testing.T) {
+	cases := []struct {
+		path string
+		want common.Address
+	}{
+		{"", common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94")},
+		{"m/44'/60'/0'/0/1", common.HexToAddress("0x6Fac4D18c912343BF86fa7049364Dd4E424Ab9C0")},
+	}
+	for _, tc := range cases {
+		signer, err := NewMnemonicSigner(testMnemonic, "", tc.path, 137)
+		if err != nil {
+			t.Fatalf("NewMnemonicSigner(%q): %v", tc.path, err)
+		}
+		if signer.Address() != tc.want {
+			t.Fatalf("path %q: expected %s, got %s", tc.path, tc.want.Hex(), signer.Address().Hex())
+		}
+	}
+
+	if _, err := NewMnemonicSigner("abandon abandon", "", "", 137); err == nil {
+		t.Fatal("expected error for invalid mnemonic")
+	}
+	if _, err := NewMnemonicSigner(testMnemonic, "", "m/44'/x", 137); err == nil {
+		t.Fatal("expected error for invalid path")
+	}
+}
+
+func TestPrivateKeySignerCloseWipesKey(t *testing.T) {
+	signer, err := NewMnemonicSigner(testMnemonic, "", "", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	key := signer.key
+	if err := signer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if key.D.Sign() != 0 {
+		t.Fatal("expected key to be zeroed")
+	}
+	if _, err := signer.SignDigest(make([]byte, 32)); !errors.Is(err, ErrSignerClosed) {
+		t.Fatalf("expected ErrSignerClosed, got %v", err)
+	}
+	if signer.Address() != common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94") {
+		t.Fatal("address should survive Close")
+	}
+}
+
+func TestSignerFromEnv(t *testing.T) {
+	signer, err := SignerFromEnv("TEST_SIGNER_", 137)
+	if err != nil || signer != nil {
+		t.Fatalf("expected no signer when unset, got %v, %v", signer, err)
+	}
+
+	dir := t.TempDir()
+	mnemonicPath := filepath.Join(dir, "mnemonic")
+	os.WriteFile(mnemonicPath, []byte(testMnemonic+"\n"), 0o600)
+	t.Setenv("TEST_SIGNER_MNEMONIC_FILE", mnemonicPath)
+	t.Setenv("TEST_SIGNER_PK", "0x0000000000000000000000000000000000000000000000000000000000000001")
+	signer, err = SignerFromEnv("TEST_SIGNER_", 137)
+	if err != nil {
+		t.Fatalf("SignerFromEnv: %v", err)
+	}
+	if want := common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94"); signer.Address() != want {
+		t.Fatalf("expected mnemonic to take precedence, got %s", signer.Address().Hex())
+	}
+
+	t.Setenv("TEST_SIGNER_KEYSTORE", filepath.Join(dir, "key.json"))
+	if _, err := SignerFromEnv("TEST_SIGNER_", 137); err == nil {
+		t.Fatal("expected error for keystore without password file")
+	}
+}
@@ -114,19 +114,29 @@ func (s *AWSSigner) SignTypedData(domain *apitypes.TypedDataDomain, typesDef api
 		return nil, fmt.Errorf("failed to unmarshal ASN.1 signature: %w", err)
 	}
 
+	return recoverableSignature(sighash, sig.R, sig.S, s.address)
+}
+
+// recoverableSignature converts an ECDSA (r, s) signature over digest into the
+// 65-byte [R || S || V] Ethereum format, with s canonicalized to the lower
+// half of the curve order and V chosen so the signature recovers to address.
+func recoverableSignature(digest []byte, r, sv *big.Int, address common.Address) ([]byte, error) {
 	// Canonicalize S: s = min(s, N-s) if s > N/2
 	// secp256k1 N
 	curveOrder := crypto.S256().Params().N
 	halfOrder := new(big.Int).Div(curveOrder, big.NewInt(2))
 
-	if sig.S.Cmp(halfOrder) > 0 {
-		sig.S = new(big.Int).Sub(curveOrder, sig.S)
+	if sv.Cmp(halfOrder) > 0 {
+		sv = new(big.Int).Sub(curveOrder, sv)
 	}
 
 	// Convert to 65-byte [R, S, V] format
 	// R and S are 32 bytes each.
-	rBytes := sig.R.Bytes()
-	sBytes := sig.S.Bytes()
+	rBytes := r.Bytes()
+	sBytes := sv.Bytes()
+	if len(rBytes) > 32 || len(sBytes) > 32 {
+		return nil, fmt.Errorf("invalid signature length")
+	}
 
 	// Pad R and S to 32 bytes
 	sigBytes := make([]byte, 65)
@@ -141,12 +151,12 @@ func (s *AWSSigner) SignTypedData(domain *apitypes.TypedDataDomain, typesDef api
 	for _, candidateV := range []byte{0, 1} {
 		sigBytes[64] = candidateV
 		// Ecrecover expects [R || S || V] where V is 0 or 1
-		pubKeyBytes, err := crypto.Ecrecover(sighash, sigBytes)
+		pubKeyBytes, err := crypto.Ecrecover(digest, sigBytes)
 		if err == nil {
 			recoveredPub, err := crypto.UnmarshalPubkey(pubKeyBytes)
 			if err == nil {
 				recoveredAddr := crypto.PubkeyToAddress(*recoveredPub)
-				if recoveredAddr == s.address {
+				if recoveredAddr == address {
 					v = candidateV
 					found = true
 					break
@@ -0,0 +1,353 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const defaultTransitMount = "transit"
+
+// oidSecp256k1 is the named-curve OID of secp256k1 (SEC 2).
+var oidSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+// VaultConfig configures a VaultSigner.
+type VaultConfig struct {
+	// Address is the Vault server URL, e.g. https://vault.example.com:8200.
+	Address string
+	// Token is the Vault token used for Transit requests.
+	Token string
+	// Namespace is the optional Vault Enterprise namespace.
+	Namespace string
+	// Mount is the Transit engine mount path. Defaults to "transit".
+	Mount string
+	// KeyName is the name of the secp256k1 Transit key.
+	KeyName string
+	// KeyVersion pins the key version used for signing. Zero uses the latest.
+	KeyVersion int
+	// HTTPClient performs requests. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Timeout bounds each Vault request. Defaults to 10 seconds.
+	Timeout time.Duration
+	// RenewIncrement is the TTL requested when renewing the token. Zero uses
+	// the token's default TTL.
+	RenewIncrement time.Duration
+}
+
+// VaultSigner implements auth.Signer using a HashiCorp Vault Transit key.
+type VaultSigner struct {
+	cfg     VaultConfig
+	chainID *big.Int
+	pubKey  *ecdsa.PublicKey
+	address common.Address
+	version int
+
+	mu        sync.RWMutex
+	token     string
+	stopRenew context.CancelFunc
+	renewDone chan struct{}
+}
+
+// NewVaultSigner creates a new signer backed by a Vault Transit key.
+// It fetches the public key from Vault to compute the address.
+func NewVaultSigner(ctx context.Context, cfg VaultConfig, chainID int64) (*VaultSigner, error) {
+	cfg.Address = strings.TrimRight(cfg.Address, "/")
+	if cfg.Address == "" || cfg.KeyName == "" {
+		return nil, fmt.Errorf("vault address and key name are required")
+	}
+	if cfg.Mount == "" {
+		cfg.Mount = defaultTransitMount
+	}
+	cfg.Mount = strings.Trim(cfg.Mount, "/")
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultKMSTimeout
+	}
+	s := &VaultSigner{cfg: cfg, chainID: big.NewInt(chainID), token: cfg.Token}
+
+	var keyResp struct {
+		Data struct {
+			Type          string `json:"type"`
+			LatestVersion int    `json:"latest_version"`
+			Keys          map[string]struct {
+				PublicKey string `json:"public_key"`
+			} `json:"keys"`
+		} `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodGet, "/v1/"+cfg.Mount+"/keys/"+cfg.KeyName, nil, &keyResp); err != nil {
+		return nil, fmt.Errorf("failed to get public key from Vault: %w", err)
+	}
+	s.version = cfg.KeyVersion
+	if s.version == 0 {
+		s.version = keyResp.Data.LatestVersion
+	}
+	key, ok := keyResp.Data.Keys[strconv.Itoa(s.version)]
+	if !ok {
+		return nil, fmt.Errorf("vault key %s has no version %d", cfg.KeyName, s.version)
+	}
+	pubKey, err := parseSecp256k1PublicKey(key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	}
+	s.pubKey = pubKey
+	s.address = crypto.PubkeyToAddress(*pubKey)
+	return s, nil
+}
+
+func (s *VaultSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *VaultSigner) ChainID() *big.Int {
+	return s.chainID
+}
+
+// SignTypedData signs EIP-712 typed data using Vault Transit.
+func (s *VaultSigner) SignTypedData(domain *apitypes.TypedDataDomain, typesDef apitypes.Types, message apitypes.TypedDataMessage, primaryType string) ([]byte, error) {
+	typedData := apitypes.TypedData{
+		Types:       typesDef,
+		PrimaryType: primaryType,
+		Domain:      *domain,
+		Message:     message,
+	}
+
+	sighash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+	return s.SignDigest(sighash)
+}
+
+// SignDigest signs a 32-byte digest using Vault Transit.
+func (s *VaultSigner) SignDigest(digest []byte) ([]byte, error) {
+	if len(digest) != 32 {
+		return nil, fmt.Errorf("digest must be 32 bytes, got %d", len(digest))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	req := map[string]any{
+		"input":                base64.StdEncoding.EncodeToString(digest),
+		"prehashed":            true,
+		"marshaling_algorithm": "asn1",
+		"key_version":          s.version,
+	}
+	var resp struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	if err := s.do(ctx, http.MethodPost, "/v1/"+s.cfg.Mount+"/sign/"+s.cfg.KeyName, req, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign with Vault: %w", err)
+	}
+
+	// Transit signatures have the form vault:v<version>:<base64 DER>.
+	parts := strings.SplitN(resp.Data.Signature, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, fmt.Errorf("unexpected Vault signature format")
+	}
+	der, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Vault signature: %w", err)
+	}
+	var sig struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ASN.1 signature: %w", err)
+	}
+	return recoverableSignature(digest, sig.R, sig.S, s.address)
+}
+
+// SetToken replaces the Vault token, e.g. after re-authenticating.
+func (s *VaultSigner) SetToken(token string) {
+	s.mu.Lock()
+	s.token = token
+	s.mu.Unlock()
+}
+
+// RenewToken renews the Vault token and returns its new lease duration and
+// whether it can be renewed again.
+func (s *VaultSigner) RenewToken(ctx context.Context) (time.Duration, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	req := map[string]any{}
+	if s.cfg.RenewIncrement > 0 {
+		req["increment"] = fmt.Sprintf("%ds", int64(s.cfg.RenewIncrement/time.Second))
+	}
+	var resp struct {
+		Auth struct {
+			ClientToken   string `json:"client_token"`
+			LeaseDuration int64  `json:"lease_duration"`
+			Renewable     bool   `json:"renewable"`
+		} `json:"auth"`
+	}
+	if err := s.do(ctx, http.MethodPost, "/v1/auth/token/renew-self", req, &resp); err != nil {
+		return 0, false, fmt.Errorf("failed to renew Vault token: %w", err)
+	}
+	if resp.Auth.ClientToken != "" {
+		s.SetToken(resp.Auth.ClientToken)
+	}
+	return time.Duration(resp.Auth.LeaseDuration) * time.Second, resp.Auth.Renewable, nil
+}
+
+// StartTokenRenewal renews the token now and then in the background at two
+// thirds of each lease, until ctx is done, Close is called or the token is no
+// longer renewable. Background renewal failures are retried after a short
+// delay and reported to onError, which may be nil.
+func (s *VaultSigner) StartTokenRenewal(ctx context.Context, onError func(error)) error {
+	lease, renewable, err := s.RenewToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopRenew != nil || !renewable {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.stopRenew = cancel
+	s.renewDone = make(chan struct{})
+	go s.renewLoop(ctx, lease, onError, s.renewDone)
+	return nil
+}
+
+func (s *VaultSigner) renewLoop(ctx context.Context, lease time.Duration, onError func(error), done chan struct{}) {
+	defer close(done)
+	for {
+		wait := lease * 2 / 3
+		if wait < time.Second {
+			wait = time.Second
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		next, renewable, err := s.RenewToken(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if onError != nil {
+				onError(err)
+			}
+			// Retry well before the remaining lease runs out.
+			lease = min(lease/3, 30*time.Second)
+			continue
+		}
+		if !renewable {
+			return
+		}
+		lease = next
+	}
+}
+
+// Close stops background token renewal.
+func (s *VaultSigner) Close() error {
+	s.mu.Lock()
+	cancel, done := s.stopRenew, s.renewDone
+	s.stopRenew, s.renewDone = nil, nil
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	return nil
+}
+
+func (s *VaultSigner) do(ctx context.Context, method, path string, body, out any) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.cfg.Address+path, reader)
+	if err != nil {
+		return err
+	}
+	s.mu.RLock()
+	token := s.token
+	s.mu.RUnlock()
+	req.Header.Set("X-Vault-Token", token)
+	if s.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.cfg.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&vaultErr)
+		err := fmt.Errorf("vault error: status %d: %s", resp.StatusCode, strings.Join(vaultErr.Errors, "; "))
+		if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%w: %v", sdkerrors.ErrUnauthorized, err)
+		}
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// parseSecp256k1PublicKey parses a PEM or DER SubjectPublicKeyInfo holding a
+// secp256k1 key, which crypto/x509 does not support.
+func parseSecp256k1PublicKey(encoded string) (*ecdsa.PublicKey, error) {
+	der := []byte(encoded)
+	if block, _ := pem.Decode(der); block != nil {
+		der = block.Bytes
+	}
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &spki); err != nil {
+		return nil, err
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(spki.Algorithm.Parameters.FullBytes, &curve); err != nil {
+		return nil, fmt.Errorf("missing curve parameters: %w", err)
+	}
+	if !curve.Equal(oidSecp256k1) {
+		return nil, fmt.Errorf("key is not a secp256k1 key (curve %s)", curve)
+	}
+	return crypto.UnmarshalPubkey(spki.PublicKey.RightAlign())
+}
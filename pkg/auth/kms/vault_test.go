@@ -0,0 +1,203 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fakeTransit is an httptest stand-in for the Vault Transit API.
+type fakeTransit struct {
+	key      *ecdsa.PrivateKey
+	token    atomic.Value
+	renewals atomic.Int32
+	delay    time.Duration
+}
+
+func newFakeTransit(t *testing.T) (*fakeTransit, *httptest.Server) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	f := &fakeTransit{key: key}
+	f.token.Store("root")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/transit/keys/eth", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{
+			"type":           "ecdsa-secp256k1",
+			"latest_version": 1,
+			"keys":           map[string]any{"1": map[string]string{"public_key": f.publicKeyPEM(t)}},
+		}})
+	})
+	mux.HandleFunc("POST /v1/transit/sign/eth", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		time.Sleep(f.delay)
+		var req struct {
+			Input     string `json:"input"`
+			Prehashed bool   `json:"prehashed"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		digest, _ := base64.StdEncoding.DecodeString(req.Input)
+		sig, err := crypto.Sign(digest, f.key)
+		if err != nil || !req.Prehashed {
+			http.Error(w, `{"errors":["bad input"]}`, http.StatusBadRequest)
+			return
+		}
+		// Return the high-S form to exercise canonicalization.
+		s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:64]))
+		der, _ := asn1.Marshal(struct{ R, S *big.Int }{new(big.Int).SetBytes(sig[:32]), s})
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]string{
+			"signature": "vault:v1:" + base64.StdEncoding.EncodeToString(der),
+		}})
+	})
+	mux.HandleFunc("POST /v1/auth/token/renew-self", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		n := f.renewals.Add(1)
+		json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{
+			"client_token":   "root",
+			"lease_duration": 1,
+			"renewable":      n < 3,
+		}})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeTransit) authorized(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("X-Vault-Token") != f.token.Load().(string) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"errors":["permission denied"]}`))
+		return false
+	}
+	return true
+}
+
+func (f *fakeTransit) publicKeyPEM(t *testing.T) string {
+	curve, _ := asn1.Marshal(oidSecp256k1)
+	der, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1},
+			Parameters: asn1.RawValue{FullBytes: curve},
+		},
+		PublicKey: asn1.BitString{Bytes: crypto.FromECDSAPub(&f.key.PublicKey), BitLength: 65 * 8},
+	})
+	if err != nil {
+		t.Fatalf("marshal public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestVaultSignerSignsRecoverableDigests(t *testing.T) {
+	f, srv := newFakeTransit(t)
+	signer, err := NewVaultSigner(context.Background(), VaultConfig{Address: srv.URL, Token: "root", KeyName: "eth"}, 137)
+	if err != nil {
+		t.Fatalf("NewVaultSigner: %v", err)
+	}
+	if signer.Address() != crypto.PubkeyToAddress(f.key.PublicKey) {
+		t.Fatalf("unexpected address %s", signer.Address().Hex())
+	}
+
+	digest := crypto.Keccak256([]byte("order"))
+	sig, err := signer.SignDigest(digest)
+	if err != nil {
+		t.Fatalf("SignDigest: %v", err)
+	}
+	if sig[64] != 27 && sig[64] != 28 {
+		t.Fatalf("unexpected v %d", sig[64])
+	}
+	if new(big.Int).SetBytes(sig[32:64]).Cmp(new(big.Int).Rsh(crypto.S256().Params().N, 1)) > 0 {
+		t.Fatal("expected canonical low-S signature")
+	}
+	recovered := append([]byte(nil), sig...)
+	recovered[64] -= 27
+	pub, err := crypto.SigToPub(digest, recovered)
+	if err != nil || crypto.PubkeyToAddress(*pub) != signer.Address() {
+		t.Fatalf("signature does not recover signer: %v", err)
+	}
+
+	signer.SetToken("expired")
+	if _, err := signer.SignDigest(digest); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestVaultSignerTimeout(t *testing.T) {
+	f, srv := newFakeTransit(t)
+	f.delay = 200 * time.Millisecond
+	signer, err := NewVaultSigner(context.Background(), VaultConfig{Address: srv.URL, Token: "root", KeyName: "eth", Timeout: 20 * time.Millisecond}, 137)
+	if err != nil {
+		t.Fatalf("NewVaultSigner: %v", err)
+	}
+	if _, err := signer.SignDigest(crypto.Keccak256([]byte("slow"))); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestVaultSignerRenewsToken(t *testing.T) {
+	f, srv := newFakeTransit(t)
+	signer, err := NewVaultSigner(context.Background(), VaultConfig{Address: srv.URL, Token: "root", KeyName: "eth", RenewIncrement: time.Hour}, 137)
+	if err != nil {
+		t.Fatalf("NewVaultSigner: %v", err)
+	}
+	if err := signer.StartTokenRenewal(context.Background(), nil); err != nil {
+		t.Fatalf("StartTokenRenewal: %v", err)
+	}
+	if got := f.renewals.Load(); got != 1 {
+		t.Fatalf("expected initial renewal, got %d", got)
+	}
+
+	// The fake reports the token as non-renewable after the third renewal,
+	// which ends the background loop.
+	deadline := time.Now().Add(5 * time.Second)
+	for f.renewals.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(20 * time.Millisecond)
+	}
+	if got := f.renewals.Load(); got != 3 {
+		t.Fatalf("expected 3 renewals, got %d", got)
+	}
+	signer.Close()
+}
+
+func TestParseSecp256k1PublicKeyRejectsOtherCurves(t *testing.T) {
+	p256, _ := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	der, _ := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		Algorithm: pkix.AlgorithmIdentifier{
+			Algorithm:  asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1},
+			Parameters: asn1.RawValue{FullBytes: p256},
+		},
+		PublicKey: asn1.BitString{Bytes: make([]byte, 65), BitLength: 65 * 8},
+	})
+	if _, err := parseSecp256k1PublicKey(string(der)); err == nil {
+		t.Fatal("expected error for non-secp256k1 key")
+	}
+}
@@ -0,0 +1,251 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Paths served by a remote signing service such as cmd/signer-server.
+const (
+	RemoteSignerInfoPath       = "/v1/signer"
+	RemoteSignTypedDataPath    = "/v1/sign-typed-data"
+	RemoteSignDigestPath       = "/v1/sign-digest"
+	defaultRemoteSignerTimeout = 10 * time.Second
+)
+
+// Headers authenticating a client to a remote signing service with a shared
+// HMAC secret.
+const (
+	HeaderSignerClientID  = "X-Signer-Client-Id"
+	HeaderSignerTimestamp = "X-Signer-Timestamp"
+	HeaderSignerSignature = "X-Signer-Signature"
+)
+
+// SignRemoteRequest returns the HeaderSignerSignature value for a request to
+// a remote signing service: the HMAC of timestamp, method, path and body
+// under the base64-encoded shared secret.
+func SignRemoteRequest(secret string, timestamp int64, method, path string, body []byte) (string, error) {
+	return SignHMAC(secret, fmt.Sprintf("%d%s%s", timestamp, method, path)+string(body))
+}
+
+// RemoteSignerInfo describes the key held by a remote signing service.
+type RemoteSignerInfo struct {
+	Address common.Address `json:"address"`
+	ChainID int64          `json:"chainId"`
+}
+
+// RemoteSignTypedDataRequest is the body of a typed-data signing request.
+type RemoteSignTypedDataRequest struct {
+	TypedData apitypes.TypedData `json:"typedData"`
+}
+
+// RemoteSignDigestRequest is the body of a digest signing request.
+type RemoteSignDigestRequest struct {
+	Digest hexutil.Bytes `json:"digest"`
+}
+
+// RemoteSignResponse is the response of both signing endpoints.
+type RemoteSignResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// RemoteSignerConfig configures a RemoteSigner.
+type RemoteSignerConfig struct {
+	// URL is the base URL of the signing service.
+	URL string
+	// Address is the expected signer address. When zero it is fetched from the
+	// service.
+	Address common.Address
+	// ChainID is the chain the signer signs for. When zero it is fetched from
+	// the service.
+	ChainID int64
+	// Token is an optional bearer token sent with every request. It must
+	// match one of the service's CLIENT_TOKENS when any clients are configured.
+	Token string
+	// HTTPClient performs requests. For mutual TLS, use a client whose
+	// transport carries a certificate, e.g. from LoadMutualTLSConfig.
+	HTTPClient BuilderHTTPDoer
+	// Timeout bounds each signing request. Defaults to 10 seconds.
+	Timeout time.Duration
+}
+
+// RemoteSigner implements Signer by forwarding signing requests to a remote
+// service over HTTP, so private keys stay out of the trading process. Every
+// returned signature is checked to recover to the expected address.
+type RemoteSigner struct {
+	cfg     RemoteSignerConfig
+	address common.Address
+	chainID *big.Int
+}
+
+// NewRemoteSigner creates a signer backed by the service at cfg.URL. If the
+// address or chain ID are not configured they are fetched from the service.
+func NewRemoteSigner(ctx context.Context, cfg RemoteSignerConfig) (*RemoteSigner, error) {
+	cfg.URL = strings.TrimRight(cfg.URL, "/")
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("remote signer URL is required")
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{Timeout: defaultRemoteSignerTimeout}
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultRemoteSignerTimeout
+	}
+	s := &RemoteSigner{cfg: cfg, address: cfg.Address, chainID: big.NewInt(cfg.ChainID)}
+	if cfg.Address == (common.Address{}) || cfg.ChainID == 0 {
+		var info RemoteSignerInfo
+		if err := s.do(ctx, http.MethodGet, RemoteSignerInfoPath, nil, &info); err != nil {
+			return nil, fmt.Errorf("fetch remote signer info: %w", err)
+		}
+		if cfg.Address == (common.Address{}) {
+			s.address = info.Address
+		} else if info.Address != cfg.Address {
+			return nil, fmt.Errorf("remote signer holds %s, expected %s", info.Address.Hex(), cfg.Address.Hex())
+		}
+		if cfg.ChainID == 0 {
+			s.chainID = big.NewInt(info.ChainID)
+		}
+	}
+	return s, nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *RemoteSigner) ChainID() *big.Int {
+	return s.chainID
+}
+
+// SignTypedData sends the typed data to the remote service for signing.
+func (s *RemoteSigner) SignTypedData(domain *apitypes.TypedDataDomain, types apitypes.Types, message apitypes.TypedDataMessage, primaryType string) ([]byte, error) {
+	typedData := apitypes.TypedData{
+		Types:       types,
+		PrimaryType: primaryType,
+		Domain:      *domain,
+		Message:     message,
+	}
+	sighash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash typed data: %w", err)
+	}
+	return s.sign(RemoteSignTypedDataPath, RemoteSignTypedDataRequest{TypedData: typedData}, sighash)
+}
+
+// SignDigest sends a 32-byte digest to the remote service for signing.
+func (s *RemoteSigner) SignDigest(digest []byte) ([]byte, error) {
+	if len(digest) != 32 {
+		return nil, fmt.Errorf("digest must be 32 bytes, got %d", len(digest))
+	}
+	return s.sign(RemoteSignDigestPath, RemoteSignDigestRequest{Digest: digest}, digest)
+}
+
+func (s *RemoteSigner) sign(path string, body any, digest []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Timeout)
+	defer cancel()
+
+	var resp RemoteSignResponse
+	if err := s.do(ctx, http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+	sig := []byte(resp.Signature)
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("%w: remote signature has %d bytes", sdkerrors.ErrInvalidSignature, len(sig))
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	recoverable := append([]byte(nil), sig...)
+	recoverable[64] -= 27
+	pub, err := crypto.SigToPub(digest, recoverable)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", sdkerrors.ErrInvalidSignature, err)
+	}
+	if got := crypto.PubkeyToAddress(*pub); got != s.address {
+		return nil, fmt.Errorf("%w: remote signature recovers to %s, expected %s", sdkerrors.ErrInvalidSignature, got.Hex(), s.address.Hex())
+	}
+	return sig, nil
+}
+
+func (s *RemoteSigner) do(ctx context.Context, method, path string, body, out any) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal remote signer request: %w", err)
+		}
+		reader = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, s.cfg.URL+path, reader)
+	if err != nil {
+		return fmt.Errorf("remote signer request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if s.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.Token)
+	}
+	resp, err := s.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("remote signer request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		err := fmt.Errorf("remote signer error: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+		if resp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%w: %v", sdkerrors.ErrUnauthorized, err)
+		}
+		return err
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode remote signer response: %w", err)
+	}
+	return nil
+}
+
+// LoadMutualTLSConfig loads a certificate key pair and a CA bundle for
+// mutual TLS. The CA bundle verifies the peer: the server certificate on a
+// client, or client certificates on a server, which must additionally set
+// ClientAuth to tls.RequireAndVerifyClientCert.
+func LoadMutualTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS key pair: %w", err)
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
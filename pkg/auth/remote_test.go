@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func newTestRemoteService(t *testing.T, signer *PrivateKeySigner, token string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(RemoteSignerInfoPath, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(RemoteSignerInfo{Address: signer.Address(), ChainID: signer.ChainID().Int64()})
+	})
+	mux.HandleFunc(RemoteSignTypedDataPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req RemoteSignTypedDataRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		td := req.TypedData
+		sig, err := signer.SignTypedData(&td.Domain, td.Types, td.Message, td.PrimaryType)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(RemoteSignResponse{Signature: sig})
+	})
+	mux.HandleFunc(RemoteSignDigestPath, func(w http.ResponseWriter, r *http.Request) {
+		var req RemoteSignDigestRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sig, _ := signer.SignDigest(req.Digest)
+		json.NewEncoder(w).Encode(RemoteSignResponse{Signature: sig})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRemoteSignerMatchesLocalSigner(t *testing.T) {
+	local, err := NewMnemonicSigner(testMnemonic, "", "", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	srv := newTestRemoteService(t, local, "secret")
+
+	remote, err := NewRemoteSigner(context.Background(), RemoteSignerConfig{URL: srv.URL + "/", Token: "secret"})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	if remote.Address() != local.Address() || remote.ChainID().Int64() != 137 {
+		t.Fatalf("unexpected remote identity %s/%s", remote.Address().Hex(), remote.ChainID())
+	}
+
+	message := apitypes.TypedDataMessage{
+		"address":   local.Address().Hex(),
+		"timestamp": "1700000000",
+		"nonce":     "0",
+		"message":   "This message attests that I control the given wallet",
+	}
+	want, err := local.SignTypedData(ClobAuthDomain, ClobAuthTypes, message, "ClobAuth")
+	if err != nil {
+		t.Fatalf("local SignTypedData: %v", err)
+	}
+	got, err := remote.SignTypedData(ClobAuthDomain, ClobAuthTypes, message, "ClobAuth")
+	if err != nil {
+		t.Fatalf("remote SignTypedData: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Fatal("remote signature differs from local signature")
+	}
+
+	if _, err := remote.SignDigest(crypto.Keccak256([]byte("digest"))); err != nil {
+		t.Fatalf("remote SignDigest: %v", err)
+	}
+
+	unauth, _ := NewRemoteSigner(context.Background(), RemoteSignerConfig{URL: srv.URL})
+	if _, err := unauth.SignTypedData(ClobAuthDomain, ClobAuthTypes, message, "ClobAuth"); !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+}
+
+func TestRemoteSignerRejectsForeignSignature(t *testing.T) {
+	other, err := NewMnemonicSigner(testMnemonic, "", "m/44'/60'/0'/0/1", 137)
+	if err != nil {
+		t.Fatalf("NewMnemonicSigner: %v", err)
+	}
+	srv := newTestRemoteService(t, other, "")
+
+	expected := common.HexToAddress("0x9858EfFD232B4033E47d90003D41EC34EcaEda94")
+	if _, err := NewRemoteSigner(context.Background(), RemoteSignerConfig{URL: srv.URL, Address: expected}); err == nil {
+		t.Fatal("expected address mismatch error")
+	}
+
+	remote, err := NewRemoteSigner(context.Background(), RemoteSignerConfig{URL: srv.URL, Address: expected, ChainID: 137})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	if _, err := remote.SignDigest(crypto.Keccak256([]byte("digest"))); !errors.Is(err, sdkerrors.ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+}
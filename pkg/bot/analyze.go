@@ -73,13 +73,8 @@ func (e *Engine) analyzeToken(ctx context.Context, m clobtypes.Market, t clobtyp
 }
 
 func topOfBook(levels []clobtypes.PriceLevel) (price decimal.Decimal, depth decimal.Decimal, err error) {
-	price, err = decimal.NewFromString(levels[0].Price)
-	if err != nil {
-		return decimal.Zero, decimal.Zero, fmt.Errorf("bad price: %w", err)
-	}
-	depth, err = decimal.NewFromString(levels[0].Size)
-	if err != nil {
-		return decimal.Zero, decimal.Zero, fmt.Errorf("bad size: %w", err)
+	if err := levels[0].Validate(); err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("bad level: %w", err)
 	}
-	return price, depth, nil
+	return levels[0].PriceDec(), levels[0].SizeDec(), nil
 }
@@ -19,3 +19,9 @@ func TestTopOfBook(t *testing.T) {
 		t.Fatalf("unexpected depth: %s", depth)
 	}
 }
+
+func TestTopOfBookInvalidLevel(t *testing.T) {
+	if _, _, err := topOfBook([]clobtypes.PriceLevel{{Price: "abc", Size: "1"}}); err == nil {
+		t.Fatal("expected error for invalid price")
+	}
+}
@@ -4,6 +4,7 @@ package clob
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
@@ -11,6 +12,7 @@ import (
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/heartbeat"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/rfq"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
 )
 
@@ -43,6 +45,9 @@ type Client interface {
 	WithHeartbeatInterval(interval time.Duration) Client
 	// StopHeartbeats stops any active heartbeat loop.
 	StopHeartbeats()
+	// WithEligibilityGuard rejects orders locally while the guard reports the
+	// host as geoblocked or the account as closed-only.
+	WithEligibilityGuard(guard *EligibilityGuard) Client
 
 	// -- High-level Helpers --
 
@@ -78,6 +83,14 @@ type Client interface {
 	SamplingMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
 	// SamplingSimplifiedMarkets retrieves a sampled and simplified list of markets.
 	SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	// MarketsIter yields markets page by page; breaking out of the loop stops fetching.
+	MarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	// SimplifiedMarketsIter yields simplified markets page by page.
+	SimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	// SamplingMarketsIter yields sampled markets page by page.
+	SamplingMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	// SamplingSimplifiedMarketsIter yields sampled simplified markets page by page.
+	SamplingSimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
 
 	// -- Order Book & Pricing --
 
@@ -156,6 +169,12 @@ type Client interface {
 	TradesAll(ctx context.Context, req *clobtypes.TradesRequest) ([]clobtypes.Trade, error)
 	// BuilderTradesAll automatically iterates through all pages to retrieve all trades attributed to a builder.
 	BuilderTradesAll(ctx context.Context, req *clobtypes.BuilderTradesRequest) ([]clobtypes.Trade, error)
+	// OrdersIter yields open orders page by page without loading them all into memory.
+	OrdersIter(ctx context.Context, req *clobtypes.OrdersRequest, opts ...paginate.Option) iter.Seq2[clobtypes.OrderResponse, error]
+	// TradesIter yields trades page by page without loading them all into memory.
+	TradesIter(ctx context.Context, req *clobtypes.TradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error]
+	// BuilderTradesIter yields builder-attributed trades page by page.
+	BuilderTradesIter(ctx context.Context, req *clobtypes.BuilderTradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error]
 
 	// -- Scoring & Performance --
 
@@ -189,6 +208,12 @@ type Client interface {
 	RewardsMarkets(ctx context.Context, req *clobtypes.RewardsMarketRequest) (clobtypes.RewardsMarketResponse, error)
 	// UserRewardsByMarket retrieves user earnings alongside market rewards configuration.
 	UserRewardsByMarket(ctx context.Context, req *clobtypes.UserRewardsByMarketRequest) (clobtypes.UserRewardsByMarketResponse, error)
+	// UserEarningsIter yields the user's reward earnings page by page.
+	UserEarningsIter(ctx context.Context, req *clobtypes.UserEarningsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.UserEarning, error]
+	// RewardsMarketsCurrentIter yields reward-eligible markets page by page.
+	RewardsMarketsCurrentIter(ctx context.Context, req *clobtypes.RewardsMarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.CurrentReward, error]
+	// RewardsMarketsIter yields a market's reward configurations page by page.
+	RewardsMarketsIter(ctx context.Context, req *clobtypes.RewardsMarketRequest, opts ...paginate.Option) iter.Seq2[clobtypes.MarketReward, error]
 
 	// -- API Key Management --
 
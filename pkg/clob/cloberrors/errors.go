@@ -31,6 +31,8 @@ func FromTypeErr(err *types.Error) error {
 		return fmt.Errorf("%w: %s", sdkerrors.ErrMarketClosed, err.Message)
 	case "GEOBLOCKED":
 		return fmt.Errorf("%w: %s", sdkerrors.ErrGeoblocked, err.Message)
+	case "CLOSED_ONLY", "CLOSE_ONLY":
+		return fmt.Errorf("%w: %s", sdkerrors.ErrClosedOnly, err.Message)
 	case "INVALID_PRICE":
 		return fmt.Errorf("%w: %s", sdkerrors.ErrInvalidPrice, err.Message)
 	case "INVALID_SIZE":
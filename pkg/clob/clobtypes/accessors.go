@@ -0,0 +1,36 @@
+package clobtypes
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+// Price levels parse on demand rather than caching, so that a decoded level
+// still compares equal to a literal one.
+
+// PriceDec returns Price as a decimal.
+func (l PriceLevel) PriceDec() decimal.Decimal {
+	value, _ := types.ParseDecimal(l.Price)
+	return value
+}
+
+// SizeDec returns Size as a decimal.
+func (l PriceLevel) SizeDec() decimal.Decimal {
+	value, _ := types.ParseDecimal(l.Size)
+	return value
+}
+
+// Validate reports fields that could not be parsed.
+func (l PriceLevel) Validate() error {
+	return errors.Join(checkDecimal("price", l.Price), checkDecimal("size", l.Size))
+}
+
+func checkDecimal(field, raw string) error {
+	if _, err := types.ParseDecimal(raw); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
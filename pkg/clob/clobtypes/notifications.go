@@ -0,0 +1,159 @@
+package clobtypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// NotificationType identifies the kind of an account notification.
+type NotificationType int
+
+const (
+	NotificationOrderCancelled NotificationType = 1
+	NotificationOrderFilled    NotificationType = 2
+	NotificationMarketResolved NotificationType = 4
+)
+
+// String returns a readable name for the notification type.
+func (t NotificationType) String() string {
+	switch t {
+	case NotificationOrderCancelled:
+		return "order_cancelled"
+	case NotificationOrderFilled:
+		return "order_filled"
+	case NotificationMarketResolved:
+		return "market_resolved"
+	default:
+		return "type_" + strconv.Itoa(int(t))
+	}
+}
+
+// UnmarshalJSON accepts the type as a JSON number or numeric string.
+func (t *NotificationType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := unmarshalOrderResponseStringLike(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = 0
+		return nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("notification type: %w", err)
+	}
+	*t = NotificationType(v)
+	return nil
+}
+
+// UnmarshalJSON accepts either JSON strings or numbers for the id and
+// timestamp fields.
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	type alias Notification
+	var raw struct {
+		alias
+		ID        json.RawMessage `json:"id"`
+		Timestamp json.RawMessage `json:"timestamp"`
+	}
+	if err := json.Unmarshal(trimmed, &raw); err != nil {
+		return err
+	}
+	next := Notification(raw.alias)
+	if err := unmarshalOrderResponseStringLike(raw.ID, &next.ID); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+	if err := unmarshalOrderResponseStringLike(raw.Timestamp, &next.Timestamp); err != nil {
+		return fmt.Errorf("timestamp: %w", err)
+	}
+	*n = next
+	return nil
+}
+
+// OrderNotification is the payload of order fill and cancellation
+// notifications.
+type OrderNotification struct {
+	OrderID      string
+	AssetID      string
+	ConditionID  string
+	Question     string
+	Outcome      string
+	Side         string
+	Price        string
+	OriginalSize string
+	MatchedSize  string
+}
+
+// ResolutionNotification is the payload of a market resolution notification.
+type ResolutionNotification struct {
+	ConditionID string
+	Question    string
+	// Outcome is the winning outcome.
+	Outcome string
+}
+
+// OrderPayload decodes the payload of a fill or cancellation notification.
+func (n Notification) OrderPayload() (OrderNotification, error) {
+	fields, err := n.payloadFields()
+	if err != nil {
+		return OrderNotification{}, err
+	}
+	var p OrderNotification
+	err = decodePayloadFields(fields, map[string]*string{
+		"order_id":      &p.OrderID,
+		"asset_id":      &p.AssetID,
+		"condition_id":  &p.ConditionID,
+		"question":      &p.Question,
+		"outcome":       &p.Outcome,
+		"side":          &p.Side,
+		"price":         &p.Price,
+		"original_size": &p.OriginalSize,
+		"matched_size":  &p.MatchedSize,
+	})
+	return p, err
+}
+
+// ResolutionPayload decodes the payload of a market resolution notification.
+func (n Notification) ResolutionPayload() (ResolutionNotification, error) {
+	fields, err := n.payloadFields()
+	if err != nil {
+		return ResolutionNotification{}, err
+	}
+	var p ResolutionNotification
+	err = decodePayloadFields(fields, map[string]*string{
+		"condition_id": &p.ConditionID,
+		"question":     &p.Question,
+		"outcome":      &p.Outcome,
+	})
+	return p, err
+}
+
+func (n Notification) payloadFields() (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	trimmed := bytes.TrimSpace(n.Payload)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return fields, nil
+	}
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return nil, fmt.Errorf("notification %s payload: %w", n.ID, err)
+	}
+	return fields, nil
+}
+
+func decodePayloadFields(fields map[string]json.RawMessage, dest map[string]*string) error {
+	for key, ptr := range dest {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+		if err := unmarshalOrderResponseStringLike(value, ptr); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -522,6 +522,11 @@ type (
 		ID      string `json:"id"`
 		Title   string `json:"title"`
 		Content string `json:"content"`
+		// Type identifies the payload; see NotificationType.
+		Type      NotificationType `json:"type,omitempty"`
+		Owner     string           `json:"owner,omitempty"`
+		Timestamp string           `json:"timestamp,omitempty"`
+		Payload   json.RawMessage  `json:"payload,omitempty"`
 	}
 
 	RewardToken struct {
@@ -796,3 +796,49 @@ func TestTrade_ExpandedFields(t *testing.T) {
 		t.Errorf("FeeRateBps = %s, want 100", trade.FeeRateBps)
 	}
 }
+
+func TestPriceLevel_Accessors(t *testing.T) {
+	var level PriceLevel
+	if err := json.Unmarshal([]byte(`{"price":"0.53","size":"220.12"}`), &level); err != nil {
+		t.Fatalf("Unmarshal error: %v", err)
+	}
+	if level.PriceDec().String() != "0.53" {
+		t.Errorf("PriceDec = %s, want 0.53", level.PriceDec())
+	}
+	if level.SizeDec().String() != "220.12" {
+		t.Errorf("SizeDec = %s, want 220.12", level.SizeDec())
+	}
+	if err := level.Validate(); err != nil {
+		t.Errorf("Validate error: %v", err)
+	}
+
+	// Literal values and fields mutated after decoding are parsed on demand.
+	level.Price = "0.6"
+	if level.PriceDec().String() != "0.6" {
+		t.Errorf("PriceDec after mutation = %s, want 0.6", level.PriceDec())
+	}
+
+	bad := PriceLevel{Price: "x", Size: "1"}
+	if !bad.PriceDec().IsZero() {
+		t.Errorf("PriceDec for invalid value = %s, want 0", bad.PriceDec())
+	}
+	if err := bad.Validate(); err == nil {
+		t.Error("expected Validate error for invalid price")
+	}
+}
+
+func TestPriceLevel_DecodedEqualsLiteral(t *testing.T) {
+	var level PriceLevel
+	if err := json.Unmarshal([]byte(`{"price":"0.5","size":"10"}`), &level); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if level != (PriceLevel{Price: "0.5", Size: "10"}) {
+		t.Fatalf("decoded level differs from literal: %+v", level)
+	}
+	if level.PriceDec().String() != "0.5" || level.SizeDec().String() != "10" || level.Validate() != nil {
+		t.Fatalf("unexpected accessors for %+v", level)
+	}
+	if err := (PriceLevel{Price: "x", Size: "1"}).Validate(); err == nil {
+		t.Fatal("expected invalid price to fail validation")
+	}
+}
@@ -0,0 +1,362 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+)
+
+const defaultRotationOverlap = time.Minute
+
+// CredentialManagerConfig configures a CredentialManager.
+type CredentialManagerConfig struct {
+	// Store caches credentials between runs. Optional.
+	Store auth.CredentialStore
+	// Nonce is the L1 nonce used when no credentials are stored.
+	Nonce int64
+	// RotateEvery is the interval between key rotations started by Start.
+	// Zero disables scheduled rotation.
+	RotateEvery time.Duration
+	// RotationOverlap is how long the previous key stays valid after a
+	// rotation before it is deleted. Defaults to one minute.
+	RotationOverlap time.Duration
+	// OnError receives errors from background rotation and key deletion.
+	// Optional.
+	OnError func(error)
+}
+
+// CredentialManager owns the L2 API credentials of a signer. It derives or
+// creates them at startup, caches them in an optional store, re-derives them
+// when the API answers 401, rotates them on a schedule, and pushes every new
+// key into the CLOB, WebSocket and RTDS clients it manages.
+//
+// clob.Client values are immutable, so use CLOB (or Do) for every call
+// instead of holding on to a client. WebSocket and RTDS clients are updated in
+// place; open streams keep running and use the new key when they
+// re-authenticate.
+type CredentialManager struct {
+	base   Client
+	signer auth.Signer
+	cfg    CredentialManagerConfig
+
+	mu         sync.RWMutex
+	client     Client
+	creds      auth.StoredCredentials
+	generation uint64
+	wsClients  []ws.Client
+	rtds       []rtds.Client
+	hooks      []func(*auth.APIKey)
+	retiring   map[*time.Timer]Client
+
+	// updateMu serializes refreshes and rotations.
+	updateMu sync.Mutex
+
+	stopMu sync.Mutex
+	stop   context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCredentialManager loads the signer's credentials from cfg.Store or, when
+// none are stored, creates or derives them with cfg.Nonce. client supplies the
+// transport and defaults; its own credentials are ignored.
+func NewCredentialManager(ctx context.Context, client Client, signer auth.Signer, cfg CredentialManagerConfig) (*CredentialManager, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if signer == nil {
+		return nil, auth.ErrMissingSigner
+	}
+	if cfg.RotationOverlap <= 0 {
+		cfg.RotationOverlap = defaultRotationOverlap
+	}
+	m := &CredentialManager{
+		base:     client,
+		signer:   signer,
+		cfg:      cfg,
+		retiring: make(map[*time.Timer]Client),
+	}
+
+	if cfg.Store != nil {
+		stored, err := cfg.Store.LoadCredentials(signer.Address())
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil && stored.APIKey.Key != "" {
+			m.apply(*stored)
+			return m, nil
+		}
+	}
+
+	resp, err := client.WithAuth(signer, nil).CreateOrDeriveAPIKeyWithNonce(ctx, cfg.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("create or derive API key: %w", err)
+	}
+	if err := m.update(resp, cfg.Nonce); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CLOB returns the client authenticated with the current credentials.
+func (m *CredentialManager) CLOB() Client {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.client
+}
+
+// APIKey returns a copy of the current credentials.
+func (m *CredentialManager) APIKey() *auth.APIKey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	key := m.creds.APIKey
+	return &key
+}
+
+// AttachWS authenticates c with the current credentials and keeps it updated.
+func (m *CredentialManager) AttachWS(c ws.Client) {
+	if c == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := m.creds.APIKey
+	c.Authenticate(m.signer, &key)
+	m.wsClients = append(m.wsClients, c)
+}
+
+// AttachRTDS authenticates c with the current credentials and keeps it
+// updated.
+func (m *CredentialManager) AttachRTDS(c rtds.Client) {
+	if c == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := m.creds.APIKey
+	c.Authenticate(&key)
+	m.rtds = append(m.rtds, c)
+}
+
+// OnUpdate registers fn to be called with every new key.
+func (m *CredentialManager) OnUpdate(fn func(*auth.APIKey)) {
+	if fn == nil {
+		return
+	}
+	m.mu.Lock()
+	m.hooks = append(m.hooks, fn)
+	m.mu.Unlock()
+}
+
+// Do calls fn with the current client. If fn fails with ErrUnauthorized, the
+// credentials are re-derived and fn is retried once.
+func (m *CredentialManager) Do(ctx context.Context, fn func(Client) error) error {
+	m.mu.RLock()
+	client, generation := m.client, m.generation
+	m.mu.RUnlock()
+
+	err := fn(client)
+	if !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		return err
+	}
+	if refreshErr := m.refresh(ctx, generation); refreshErr != nil {
+		return fmt.Errorf("%w (re-derive failed: %v)", err, refreshErr)
+	}
+	return fn(m.CLOB())
+}
+
+// Refresh re-derives the credentials for the current nonce, creating them if
+// the server no longer knows the key.
+func (m *CredentialManager) Refresh(ctx context.Context) error {
+	m.mu.RLock()
+	generation := m.generation
+	m.mu.RUnlock()
+	return m.refresh(ctx, generation)
+}
+
+// refresh re-derives the credentials unless they changed since generation,
+// so concurrent 401s trigger a single re-derivation.
+func (m *CredentialManager) refresh(ctx context.Context, generation uint64) error {
+	m.updateMu.Lock()
+	defer m.updateMu.Unlock()
+
+	m.mu.RLock()
+	current, nonce := m.generation, m.creds.Nonce
+	m.mu.RUnlock()
+	if current != generation {
+		return nil
+	}
+
+	l1 := m.base.WithAuth(m.signer, nil)
+	resp, err := l1.DeriveAPIKeyWithNonce(ctx, nonce)
+	if err != nil {
+		resp, err = l1.CreateAPIKeyWithNonce(ctx, nonce)
+	}
+	if err != nil {
+		return fmt.Errorf("re-derive API key: %w", err)
+	}
+	return m.update(resp, nonce)
+}
+
+// Rotate creates a key with the next nonce and switches every client to it.
+// The previous key is deleted once the rotation overlap has elapsed, so
+// requests already signed with it still succeed.
+func (m *CredentialManager) Rotate(ctx context.Context) error {
+	m.updateMu.Lock()
+	defer m.updateMu.Unlock()
+
+	m.mu.RLock()
+	previous, nonce := m.client, m.creds.Nonce+1
+	m.mu.RUnlock()
+
+	resp, err := m.base.WithAuth(m.signer, nil).CreateOrDeriveAPIKeyWithNonce(ctx, nonce)
+	if err != nil {
+		return fmt.Errorf("rotate API key: %w", err)
+	}
+	if err := m.update(resp, nonce); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	var timer *time.Timer
+	timer = time.AfterFunc(m.cfg.RotationOverlap, func() {
+		m.mu.Lock()
+		_, pending := m.retiring[timer]
+		delete(m.retiring, timer)
+		m.mu.Unlock()
+		if pending {
+			m.retire(previous)
+		}
+	})
+	m.retiring[timer] = previous
+	m.mu.Unlock()
+	return nil
+}
+
+// Start rotates the key every cfg.RotateEvery until ctx is done or Close is
+// called. It does nothing when scheduled rotation is disabled.
+func (m *CredentialManager) Start(ctx context.Context) {
+	if m.cfg.RotateEvery <= 0 {
+		return
+	}
+	m.stopMu.Lock()
+	defer m.stopMu.Unlock()
+	if m.stop != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	m.stop = cancel
+	m.done = make(chan struct{})
+	go m.rotateLoop(ctx, m.done)
+}
+
+func (m *CredentialManager) rotateLoop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(m.cfg.RotateEvery)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.Rotate(ctx); err != nil && ctx.Err() == nil {
+				m.reportError(err)
+			}
+		}
+	}
+}
+
+// Close stops scheduled rotation and deletes keys still waiting out their
+// rotation overlap.
+func (m *CredentialManager) Close() error {
+	m.stopMu.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.stopMu.Unlock()
+	if stop != nil {
+		stop()
+		<-done
+	}
+
+	m.mu.Lock()
+	var pending []Client
+	for timer, client := range m.retiring {
+		if timer.Stop() {
+			pending = append(pending, client)
+		}
+	}
+	clear(m.retiring)
+	m.mu.Unlock()
+	for _, client := range pending {
+		m.retire(client)
+	}
+	return nil
+}
+
+func (m *CredentialManager) retire(client Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := client.DeleteAPIKey(ctx, ""); err != nil {
+		m.reportError(fmt.Errorf("delete rotated API key: %w", err))
+	}
+}
+
+func (m *CredentialManager) reportError(err error) {
+	if m.cfg.OnError != nil {
+		m.cfg.OnError(err)
+	}
+}
+
+// update stores and applies newly created or derived credentials.
+func (m *CredentialManager) update(resp clobtypes.APIKeyResponse, nonce int64) error {
+	if resp.APIKey == "" || resp.Secret == "" || resp.Passphrase == "" {
+		return fmt.Errorf("%w: API key response is incomplete", auth.ErrMissingCreds)
+	}
+	creds := auth.StoredCredentials{
+		APIKey:    auth.APIKey{Key: resp.APIKey, Secret: resp.Secret, Passphrase: resp.Passphrase},
+		Nonce:     nonce,
+		CreatedAt: time.Now().UTC(),
+	}
+	if m.cfg.Store != nil {
+		if err := m.cfg.Store.SaveCredentials(m.signer.Address(), &creds); err != nil {
+			return err
+		}
+	}
+	m.apply(creds)
+	return nil
+}
+
+func (m *CredentialManager) apply(creds auth.StoredCredentials) {
+	key := creds.APIKey
+	client := m.base.WithAuth(m.signer, &key)
+
+	m.mu.Lock()
+	if m.client != nil {
+		m.client.StopHeartbeats()
+	}
+	m.client = client
+	m.creds = creds
+	m.generation++
+	for _, c := range m.wsClients {
+		wsKey := key
+		c.Authenticate(m.signer, &wsKey)
+	}
+	for _, c := range m.rtds {
+		rtdsKey := key
+		c.Authenticate(&rtdsKey)
+	}
+	hooks := append([]func(*auth.APIKey){}, m.hooks...)
+	m.mu.Unlock()
+
+	for _, fn := range hooks {
+		hookKey := key
+		fn(&hookKey)
+	}
+}
@@ -0,0 +1,234 @@
+package clob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+// fakeKeyServer issues one API key per nonce and answers 401 for keys it no
+// longer knows.
+type fakeKeyServer struct {
+	mu      sync.Mutex
+	byNonce map[int64]clobtypes.APIKeyResponse
+	valid   map[string]bool
+	deleted []string
+	creates int
+	serial  int
+}
+
+func newFakeKeyServer(t *testing.T) (*fakeKeyServer, Client) {
+	t.Helper()
+	f := &fakeKeyServer{byNonce: make(map[int64]clobtypes.APIKeyResponse), valid: make(map[string]bool)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /auth/api-key", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		nonce, _ := strconv.ParseInt(r.Header.Get(auth.HeaderPolyNonce), 10, 64)
+		if _, ok := f.byNonce[nonce]; ok {
+			http.Error(w, `{"error":"key exists"}`, http.StatusBadRequest)
+			return
+		}
+		f.creates++
+		json.NewEncoder(w).Encode(f.issue(nonce))
+	})
+	mux.HandleFunc("GET /auth/derive-api-key", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		nonce, _ := strconv.ParseInt(r.Header.Get(auth.HeaderPolyNonce), 10, 64)
+		key, ok := f.byNonce[nonce]
+		if !ok {
+			http.Error(w, `{"error":"not found"}`, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(key)
+	})
+	mux.HandleFunc("GET /auth/api-keys", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		json.NewEncoder(w).Encode(clobtypes.APIKeyListResponse{})
+	})
+	mux.HandleFunc("DELETE /auth/api-key", func(w http.ResponseWriter, r *http.Request) {
+		if !f.authorized(w, r) {
+			return
+		}
+		f.mu.Lock()
+		key := r.Header.Get(auth.HeaderPolyAPIKey)
+		f.valid[key] = false
+		f.deleted = append(f.deleted, key)
+		f.mu.Unlock()
+		json.NewEncoder(w).Encode(clobtypes.APIKeyResponse{APIKey: key})
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return f, NewClient(transport.NewClient(srv.Client(), srv.URL))
+}
+
+func (f *fakeKeyServer) issue(nonce int64) clobtypes.APIKeyResponse {
+	f.serial++
+	key := clobtypes.APIKeyResponse{
+		APIKey:     fmt.Sprintf("key-%d-%d", nonce, f.serial),
+		Secret:     "c2VjcmV0",
+		Passphrase: "pass",
+	}
+	f.byNonce[nonce] = key
+	f.valid[key.APIKey] = true
+	return key
+}
+
+// reset replaces the key for nonce, invalidating the old one.
+func (f *fakeKeyServer) reset(nonce int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.valid[f.byNonce[nonce].APIKey] = false
+	f.issue(nonce)
+}
+
+func (f *fakeKeyServer) authorized(w http.ResponseWriter, r *http.Request) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.valid[r.Header.Get(auth.HeaderPolyAPIKey)] {
+		http.Error(w, `{"error":"Unauthorized/Invalid api key"}`, http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
+
+type memoryCredentialStore struct {
+	mu    sync.Mutex
+	creds map[common.Address]auth.StoredCredentials
+}
+
+func (s *memoryCredentialStore) LoadCredentials(address common.Address) (*auth.StoredCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	creds, ok := s.creds[address]
+	if !ok {
+		return nil, nil
+	}
+	return &creds, nil
+}
+
+func (s *memoryCredentialStore) SaveCredentials(address common.Address, creds *auth.StoredCredentials) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.creds[address] = *creds
+	return nil
+}
+
+type authRecordingWS struct {
+	ws.Client
+	key *auth.APIKey
+}
+
+func (c *authRecordingWS) Authenticate(_ auth.Signer, apiKey *auth.APIKey) ws.Client {
+	c.key = apiKey
+	return c
+}
+
+type authRecordingRTDS struct {
+	rtds.Client
+	key *auth.APIKey
+}
+
+func (c *authRecordingRTDS) Authenticate(apiKey *auth.APIKey) rtds.Client {
+	c.key = apiKey
+	return c
+}
+
+func TestCredentialManagerCachesAndRederives(t *testing.T) {
+	f, client := newFakeKeyServer(t)
+	signer := mustSigner(t)
+	store := &memoryCredentialStore{creds: make(map[common.Address]auth.StoredCredentials)}
+	ctx := context.Background()
+
+	m, err := NewCredentialManager(ctx, client, signer, CredentialManagerConfig{Store: store})
+	if err != nil {
+		t.Fatalf("NewCredentialManager: %v", err)
+	}
+	first := m.APIKey().Key
+	if stored, _ := store.LoadCredentials(signer.Address()); stored == nil || stored.APIKey.Key != first {
+		t.Fatalf("expected credentials to be stored, got %+v", stored)
+	}
+
+	// A second process picks the credentials up from the store.
+	if _, err := NewCredentialManager(ctx, client, signer, CredentialManagerConfig{Store: store}); err != nil {
+		t.Fatalf("NewCredentialManager from store: %v", err)
+	}
+	if f.creates != 1 {
+		t.Fatalf("expected a single key creation, got %d", f.creates)
+	}
+
+	wsClient, rtdsClient := &authRecordingWS{}, &authRecordingRTDS{}
+	m.AttachWS(wsClient)
+	m.AttachRTDS(rtdsClient)
+
+	f.reset(0)
+	calls := 0
+	err = m.Do(ctx, func(c Client) error {
+		calls++
+		_, err := c.ListAPIKeys(ctx)
+		return err
+	})
+	if err != nil || calls != 2 {
+		t.Fatalf("expected retry after re-derive, got calls=%d err=%v", calls, err)
+	}
+	current := m.APIKey().Key
+	if current == first || wsClient.key.Key != current || rtdsClient.key.Key != current {
+		t.Fatalf("new key not pushed: manager=%s ws=%s rtds=%s", current, wsClient.key.Key, rtdsClient.key.Key)
+	}
+	if stored, _ := store.LoadCredentials(signer.Address()); stored.APIKey.Key != current {
+		t.Fatal("re-derived key was not stored")
+	}
+}
+
+func TestCredentialManagerRotatesWithOverlap(t *testing.T) {
+	f, client := newFakeKeyServer(t)
+	ctx := context.Background()
+	m, err := NewCredentialManager(ctx, client, mustSigner(t), CredentialManagerConfig{RotationOverlap: time.Hour})
+	if err != nil {
+		t.Fatalf("NewCredentialManager: %v", err)
+	}
+	old := m.CLOB()
+	oldKey := m.APIKey().Key
+
+	var updates []string
+	m.OnUpdate(func(k *auth.APIKey) { updates = append(updates, k.Key) })
+	if err := m.Rotate(ctx); err != nil {
+		t.Fatalf("Rotate: %v", err)
+	}
+	if len(updates) != 1 || updates[0] == oldKey {
+		t.Fatalf("unexpected updates %v", updates)
+	}
+	// Both keys work during the overlap.
+	if _, err := old.ListAPIKeys(ctx); err != nil {
+		t.Fatalf("old key rejected during overlap: %v", err)
+	}
+	if _, err := m.CLOB().ListAPIKeys(ctx); err != nil {
+		t.Fatalf("new key rejected: %v", err)
+	}
+
+	// Close retires keys still in their overlap.
+	m.Close()
+	if len(f.deleted) != 1 || f.deleted[0] != oldKey {
+		t.Fatalf("expected %s to be deleted, got %v", oldKey, f.deleted)
+	}
+	if _, err := m.CLOB().ListAPIKeys(ctx); err != nil {
+		t.Fatalf("new key rejected after retiring old key: %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
+)
+
+const (
+	defaultEligibilityInterval = 5 * time.Minute
+	eligibilityChangeBuffer    = 16
+)
+
+// EligibilityAPI is the part of Client used by EligibilityGuard.
+type EligibilityAPI interface {
+	Geoblock(ctx context.Context) (clobtypes.GeoblockResponse, error)
+	ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error)
+}
+
+// Eligibility is the cached trading eligibility of this host and account.
+type Eligibility struct {
+	Geoblocked bool
+	ClosedOnly bool
+	IP         string
+	Country    string
+	Region     string
+	CheckedAt  time.Time
+	// Err is the error of the last check. Flags whose check failed keep their
+	// previous value.
+	Err error
+}
+
+// CanTrade reports whether new positions may be opened.
+func (e Eligibility) CanTrade() bool { return !e.Geoblocked && !e.ClosedOnly }
+
+// EligibilityChange is published when the geoblock or closed-only state flips.
+type EligibilityChange struct {
+	Previous Eligibility
+	Current  Eligibility
+}
+
+// EligibilityGuardConfig controls eligibility checks.
+type EligibilityGuardConfig struct {
+	// Interval between background checks. Defaults to 5 minutes.
+	Interval time.Duration
+	// SkipClosedOnly disables the closed-only check, which needs L2 credentials.
+	SkipClosedOnly bool
+}
+
+// EligibilityGuard caches geoblock and closed-only status so orders can be
+// rejected locally instead of failing at the exchange. Attach it to a client
+// with Client.WithEligibilityGuard. It is safe for concurrent use.
+type EligibilityGuard struct {
+	api EligibilityAPI
+	cfg EligibilityGuardConfig
+
+	mu      sync.RWMutex
+	state   Eligibility
+	checked bool
+	subs    map[int]*eligibilitySub
+	nextSub int
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+type eligibilitySub struct {
+	ch     chan EligibilityChange
+	errs   chan error
+	missed int
+}
+
+// NewEligibilityGuard creates a guard checking through api.
+func NewEligibilityGuard(api EligibilityAPI, cfg EligibilityGuardConfig) *EligibilityGuard {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultEligibilityInterval
+	}
+	return &EligibilityGuard{api: api, cfg: cfg, subs: make(map[int]*eligibilitySub)}
+}
+
+// Start checks eligibility once and then keeps refreshing it in the
+// background until Stop is called or ctx is done. The initial result is
+// returned; a failed initial check is reported but does not stop the loop.
+func (g *EligibilityGuard) Start(ctx context.Context) (Eligibility, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	state, err := g.Refresh(ctx)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.cancel != nil {
+		return state, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	g.cancel = cancel
+	g.done = make(chan struct{})
+	go g.loop(ctx, g.done)
+	return state, err
+}
+
+// Stop ends background checks and closes all change streams.
+func (g *EligibilityGuard) Stop() {
+	g.mu.Lock()
+	cancel, done := g.cancel, g.done
+	g.cancel, g.done = nil, nil
+	subs := g.subs
+	g.subs = make(map[int]*eligibilitySub)
+	g.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	for _, sub := range subs {
+		close(sub.ch)
+		close(sub.errs)
+	}
+}
+
+func (g *EligibilityGuard) loop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(g.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.Refresh(ctx)
+		}
+	}
+}
+
+// Refresh checks eligibility now and updates the cache.
+func (g *EligibilityGuard) Refresh(ctx context.Context) (Eligibility, error) {
+	g.mu.RLock()
+	next := g.state
+	g.mu.RUnlock()
+
+	var errs []error
+	answered := false
+	geo, err := g.api.Geoblock(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("geoblock check: %w", err))
+	} else {
+		answered = true
+		next.Geoblocked = geo.Blocked
+		next.IP, next.Country, next.Region = geo.IP, geo.Country, geo.Region
+	}
+	if !g.cfg.SkipClosedOnly {
+		closed, err := g.api.ClosedOnlyStatus(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("closed-only check: %w", err))
+		} else {
+			answered = true
+			next.ClosedOnly = closed.ClosedOnly
+		}
+	}
+	next.CheckedAt = time.Now()
+	next.Err = errors.Join(errs...)
+
+	g.mu.Lock()
+	prev, wasChecked := g.state, g.checked
+	g.state = next
+	g.checked = wasChecked || answered
+	changed := (wasChecked && (prev.Geoblocked != next.Geoblocked || prev.ClosedOnly != next.ClosedOnly)) ||
+		(!wasChecked && g.checked && !next.CanTrade())
+	if changed {
+		g.publishLocked(EligibilityChange{Previous: prev, Current: next})
+	}
+	g.mu.Unlock()
+	return next, next.Err
+}
+
+// Status returns the cached eligibility and whether any check has succeeded.
+func (g *EligibilityGuard) Status() (Eligibility, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.state, g.checked
+}
+
+// CheckOrder returns an error wrapping sdkerrors.ErrGeoblocked or
+// sdkerrors.ErrClosedOnly when an order on side may not be placed. Sell
+// orders, which reduce positions, remain allowed in closed-only mode. Orders
+// are allowed until the first successful check.
+func (g *EligibilityGuard) CheckOrder(side string) error {
+	if g == nil {
+		return nil
+	}
+	state, checked := g.Status()
+	if !checked {
+		return nil
+	}
+	if state.Geoblocked {
+		return fmt.Errorf("%w: country=%s region=%s", sdkerrors.ErrGeoblocked, state.Country, state.Region)
+	}
+	if state.ClosedOnly && !strings.EqualFold(side, "SELL") {
+		return fmt.Errorf("%w: only sell orders are allowed", sdkerrors.ErrClosedOnly)
+	}
+	return nil
+}
+
+// Changes returns a stream of eligibility changes. Changes are dropped,
+// and reported as stream.LaggedError, when the consumer falls behind.
+func (g *EligibilityGuard) Changes() *stream.Stream[EligibilityChange] {
+	sub := &eligibilitySub{
+		ch:   make(chan EligibilityChange, eligibilityChangeBuffer),
+		errs: make(chan error, 1),
+	}
+	g.mu.Lock()
+	id := g.nextSub
+	g.nextSub++
+	g.subs[id] = sub
+	g.mu.Unlock()
+
+	return stream.New(sub.ch, sub.errs, func() error {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+		if _, ok := g.subs[id]; ok {
+			delete(g.subs, id)
+			close(sub.ch)
+			close(sub.errs)
+		}
+		return nil
+	})
+}
+
+func (g *EligibilityGuard) publishLocked(change EligibilityChange) {
+	for _, sub := range g.subs {
+		select {
+		case sub.ch <- change:
+		default:
+			sub.missed++
+			select {
+			case sub.errs <- stream.LaggedError{Count: sub.missed, Op: "eligibility"}:
+				sub.missed = 0
+			default:
+			}
+		}
+	}
+}
+
+// checkEligibility applies the attached guard, if any, to orders on side.
+func (c *clientImpl) checkEligibility(side string) error {
+	return c.eligibility.CheckOrder(side)
+}
+
+// WithEligibilityGuard returns a client that rejects orders the guard
+// reports as ineligible before signing or submitting them.
+func (c *clientImpl) WithEligibilityGuard(guard *EligibilityGuard) Client {
+	return &clientImpl{
+		httpClient:        c.httpClient,
+		signer:            c.signer,
+		apiKey:            c.apiKey,
+		builderCfg:        c.builderCfg,
+		signatureType:     c.signatureType,
+		authNonce:         c.authNonce,
+		funder:            c.funder,
+		saltGenerator:     c.saltGenerator,
+		cache:             c.cache,
+		geoblockHost:      c.geoblockHost,
+		geoblockClient:    c.geoblockClient,
+		rfq:               c.rfq,
+		ws:                c.ws,
+		heartbeat:         c.heartbeat,
+		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       guard,
+	}
+}
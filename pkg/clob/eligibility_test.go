@@ -0,0 +1,117 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+)
+
+type fakeEligibility struct {
+	mu         sync.Mutex
+	blocked    bool
+	closedOnly bool
+	closedErr  error
+}
+
+func (f *fakeEligibility) Geoblock(ctx context.Context) (clobtypes.GeoblockResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return clobtypes.GeoblockResponse{Blocked: f.blocked, Country: "XX"}, nil
+}
+
+func (f *fakeEligibility) ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return clobtypes.ClosedOnlyResponse{ClosedOnly: f.closedOnly}, f.closedErr
+}
+
+func (f *fakeEligibility) set(blocked, closedOnly bool) {
+	f.mu.Lock()
+	f.blocked, f.closedOnly = blocked, closedOnly
+	f.mu.Unlock()
+}
+
+func TestEligibilityGuardRejectsOrders(t *testing.T) {
+	api := &fakeEligibility{}
+	guard := NewEligibilityGuard(api, EligibilityGuardConfig{})
+	client := &clientImpl{eligibility: guard}
+
+	// Unknown state does not block trading.
+	if err := guard.CheckOrder("BUY"); err != nil {
+		t.Fatalf("unchecked guard rejected order: %v", err)
+	}
+
+	api.set(true, false)
+	if _, err := guard.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	_, err := client.CreateOrder(context.Background(), &clobtypes.Order{Side: "BUY"})
+	if !errors.Is(err, sdkerrors.ErrGeoblocked) {
+		t.Fatalf("expected ErrGeoblocked, got %v", err)
+	}
+
+	api.set(false, true)
+	guard.Refresh(context.Background())
+	if err := guard.CheckOrder("SELL"); err != nil {
+		t.Fatalf("sell rejected in closed-only mode: %v", err)
+	}
+	_, err = client.PostOrders(context.Background(), &clobtypes.SignedOrders{Orders: []clobtypes.SignedOrder{
+		{Order: clobtypes.Order{Side: "SELL"}},
+		{Order: clobtypes.Order{Side: "BUY"}},
+	}})
+	if !errors.Is(err, sdkerrors.ErrClosedOnly) {
+		t.Fatalf("expected ErrClosedOnly, got %v", err)
+	}
+}
+
+func TestEligibilityGuardKeepsStateOnFailedCheck(t *testing.T) {
+	api := &fakeEligibility{closedOnly: true}
+	guard := NewEligibilityGuard(api, EligibilityGuardConfig{})
+	guard.Refresh(context.Background())
+
+	api.mu.Lock()
+	api.closedOnly, api.closedErr = false, errors.New("unauthorized")
+	api.mu.Unlock()
+	state, err := guard.Refresh(context.Background())
+	if err == nil || !state.ClosedOnly {
+		t.Fatalf("expected previous closed-only state with error, got %+v err=%v", state, err)
+	}
+
+	skip := NewEligibilityGuard(api, EligibilityGuardConfig{SkipClosedOnly: true})
+	if state, err := skip.Refresh(context.Background()); err != nil || state.ClosedOnly {
+		t.Fatalf("skip closed-only: %+v err=%v", state, err)
+	}
+}
+
+func TestEligibilityGuardChanges(t *testing.T) {
+	api := &fakeEligibility{}
+	guard := NewEligibilityGuard(api, EligibilityGuardConfig{Interval: 5 * time.Millisecond})
+	changes := guard.Changes()
+
+	if _, err := guard.Start(context.Background()); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	api.set(true, false)
+
+	select {
+	case change := <-changes.C:
+		if change.Previous.Geoblocked || !change.Current.Geoblocked || change.Current.Country != "XX" {
+			t.Fatalf("unexpected change %+v", change)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for change")
+	}
+
+	guard.Stop()
+	if _, ok := <-changes.C; ok {
+		t.Fatal("expected change stream to be closed by Stop")
+	}
+	if err := changes.Close(); err != nil {
+		t.Fatalf("Close after Stop: %v", err)
+	}
+}
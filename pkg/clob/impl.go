@@ -35,6 +35,7 @@ type clientImpl struct {
 	heartbeat      heartbeat.Client
 
 	heartbeatInterval time.Duration
+	eligibility       *EligibilityGuard
 	heartbeatStop     chan struct{}
 	heartbeatMu       sync.Mutex
 }
@@ -69,6 +70,7 @@ func (c *clientImpl) cloneWithTransport(httpClient *transport.Client) *clientImp
 		ws:                c.ws,
 		heartbeat:         heartbeat.NewClient(httpClient),
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 	if httpClient != nil {
 		newC.geoblockClient = httpClient.CloneWithBaseURL(newC.geoblockHost)
@@ -192,6 +194,7 @@ func (c *clientImpl) WithSignatureType(sigType auth.SignatureType) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 }
 
@@ -213,6 +216,7 @@ func (c *clientImpl) WithAuthNonce(nonce int64) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 }
 
@@ -234,6 +238,7 @@ func (c *clientImpl) WithFunder(funder types.Address) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 }
 
@@ -255,6 +260,7 @@ func (c *clientImpl) WithSaltGenerator(gen SaltGenerator) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 }
 
@@ -296,6 +302,7 @@ func (c *clientImpl) WithWS(ws ws.Client) Client {
 		ws:                ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: c.heartbeatInterval,
+		eligibility:       c.eligibility,
 	}
 }
 
@@ -316,6 +323,7 @@ func (c *clientImpl) WithHeartbeatInterval(interval time.Duration) Client {
 		ws:                c.ws,
 		heartbeat:         c.heartbeat,
 		heartbeatInterval: interval,
+		eligibility:       c.eligibility,
 	}
 	newC.startHeartbeats()
 	return newC
@@ -25,6 +25,11 @@ func (c *clientImpl) CreateOrder(ctx context.Context, order *clobtypes.Order) (c
 }
 
 func (c *clientImpl) CreateOrderWithOptions(ctx context.Context, order *clobtypes.Order, opts *clobtypes.OrderOptions) (clobtypes.OrderResponse, error) {
+	if order != nil {
+		if err := c.checkEligibility(order.Side); err != nil {
+			return clobtypes.OrderResponse{}, err
+		}
+	}
 	signed, err := c.signOrder(order)
 	if err != nil {
 		return clobtypes.OrderResponse{}, err
@@ -64,23 +69,64 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 	if apiKey == nil {
 		return nil, auth.ErrMissingCreds
 	}
+	if err := resolveOrder(signer, order, sigType, funder, saltGen); err != nil {
+		return nil, err
+	}
+
+	owner := apiKey.Key
+	if owner == "" {
+		owner = signer.Address().String()
+	}
+
+	if orderSignatureType(order) == int(auth.SignaturePoly1271) {
+		sig, err := signPoly1271Order(signer, order)
+		if err != nil {
+			return nil, fmt.Errorf("sign POLY_1271 order: %w", err)
+		}
+
+		return &clobtypes.SignedOrder{
+			Order:     *order,
+			Signature: sig,
+			Owner:     owner,
+		}, nil
+	}
+
+	typedData, err := orderTypedData(order, signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signer.SignTypedData(&typedData.Domain, typedData.Types, typedData.Message, typedData.PrimaryType)
+	if err != nil {
+		return nil, fmt.Errorf("signing failed: %w", err)
+	}
+
+	return &clobtypes.SignedOrder{
+		Order:     *order,
+		Signature: hexutil.Encode(sig),
+		Owner:     owner,
+	}, nil
+}
+
+// resolveOrder validates order and fills in the fields needed for signing:
+// signature type, maker, signer, salt and, for POLY_1271, the timestamp.
+func resolveOrder(signer auth.Signer, order *clobtypes.Order, sigType *auth.SignatureType, funder *types.Address, saltGen SaltGenerator) error {
 	if order == nil {
-		return nil, fmt.Errorf("order is required")
+		return fmt.Errorf("order is required")
 	}
 
 	side := strings.ToUpper(strings.TrimSpace(order.Side))
 	if side != "BUY" && side != "SELL" {
-		return nil, fmt.Errorf("order side must be BUY or SELL, got %q", order.Side)
+		return fmt.Errorf("order side must be BUY or SELL, got %q", order.Side)
 	}
 	order.Side = side
 	if order.TokenID.Int == nil || order.TokenID.Int.Sign() == 0 {
-		return nil, fmt.Errorf("token_id is required and must be non-zero")
+		return fmt.Errorf("token_id is required and must be non-zero")
 	}
 	if order.MakerAmount.BigInt() == nil || order.MakerAmount.BigInt().Sign() <= 0 {
-		return nil, fmt.Errorf("maker_amount must be positive")
+		return fmt.Errorf("maker_amount must be positive")
 	}
 	if order.TakerAmount.BigInt() == nil || order.TakerAmount.BigInt().Sign() <= 0 {
-		return nil, fmt.Errorf("taker_amount must be positive")
+		return fmt.Errorf("taker_amount must be positive")
 	}
 
 	sigTypeVal := int(auth.SignatureEOA)
@@ -95,28 +141,23 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 	if order.Maker == (types.Address{}) {
 		if funder != nil {
 			if sigTypeVal == int(auth.SignatureEOA) {
-				return nil, fmt.Errorf("funder requires non-EOA signature type")
+				return fmt.Errorf("funder requires non-EOA signature type")
 			}
 			if *funder == (types.Address{}) {
-				return nil, fmt.Errorf("funder cannot be zero address")
+				return fmt.Errorf("funder cannot be zero address")
 			}
 			order.Maker = *funder
 		} else {
 			maker, err := deriveMakerFromSignature(signer, sigTypeVal)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			order.Maker = maker
 		}
 	}
 
 	if order.Maker == (types.Address{}) {
-		return nil, fmt.Errorf("maker address cannot be zero; ensure signer is properly initialized")
-	}
-
-	sideInt := 0
-	if side == "SELL" {
-		sideInt = 1
+		return fmt.Errorf("maker address cannot be zero; ensure signer is properly initialized")
 	}
 
 	if order.Salt.Int == nil || order.Salt.Int.Sign() == 0 {
@@ -128,7 +169,7 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 			salt, err = generateSalt()
 		}
 		if err != nil {
-			return nil, err
+			return err
 		}
 		order.Salt = types.U256{Int: salt}
 	}
@@ -140,54 +181,35 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 		if order.Timestamp == 0 {
 			order.Timestamp = time.Now().UnixMilli()
 		}
-		sig, err := signPoly1271Order(signer, order)
-		if err != nil {
-			return nil, fmt.Errorf("sign POLY_1271 order: %w", err)
-		}
-
-		owner := apiKey.Key
-		if owner == "" {
-			owner = signer.Address().String()
-		}
-
-		return &clobtypes.SignedOrder{
-			Order:     *order,
-			Signature: sig,
-			Owner:     owner,
-		}, nil
+		return nil
 	}
 
 	if order.Signer == (types.Address{}) {
 		order.Signer = signer.Address()
 	}
+	return nil
+}
 
-	domain := &apitypes.TypedDataDomain{
-		Name:              "Polymarket CTF Exchange",
-		Version:           "2",
-		ChainId:           (*math.HexOrDecimal256)(signer.ChainID()),
-		VerifyingContract: "0xE111180000d2663C0091e4f400237545B87B996B", // V2 CTF Exchange (Mainnet)
+// orderSignatureType returns the order's signature type, defaulting to EOA.
+func orderSignatureType(order *clobtypes.Order) int {
+	if order.SignatureType != nil {
+		return *order.SignatureType
 	}
+	return int(auth.SignatureEOA)
+}
 
-	typesDef := apitypes.Types{
-		"EIP712Domain": {
-			{Name: "name", Type: "string"},
-			{Name: "version", Type: "string"},
-			{Name: "chainId", Type: "uint256"},
-			{Name: "verifyingContract", Type: "address"},
-		},
-		"Order": {
-			{Name: "salt", Type: "uint256"},
-			{Name: "maker", Type: "address"},
-			{Name: "signer", Type: "address"},
-			{Name: "tokenId", Type: "uint256"},
-			{Name: "makerAmount", Type: "uint256"},
-			{Name: "takerAmount", Type: "uint256"},
-			{Name: "side", Type: "uint8"},
-			{Name: "signatureType", Type: "uint8"},
-			{Name: "timestamp", Type: "uint256"},
-			{Name: "metadata", Type: "bytes32"},
-			{Name: "builder", Type: "bytes32"},
-		},
+// orderTypedData returns the EIP-712 typed data of a resolved EOA, Proxy or
+// Safe order on the V2 CTF Exchange.
+func orderTypedData(order *clobtypes.Order, chainID *big.Int) (apitypes.TypedData, error) {
+	sideInt, err := poly1271Side(order.Side)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	domain := apitypes.TypedDataDomain{
+		Name:              ExchangeDomainName,
+		Version:           ExchangeDomainVersion,
+		ChainId:           (*math.HexOrDecimal256)(chainID),
+		VerifyingContract: ExchangeV2Address,
 	}
 
 	message := apitypes.TypedDataMessage{
@@ -198,31 +220,65 @@ func signOrderWithCreds(signer auth.Signer, apiKey *auth.APIKey, order *clobtype
 		"makerAmount":   (*math.HexOrDecimal256)(order.MakerAmount.BigInt()),
 		"takerAmount":   (*math.HexOrDecimal256)(order.TakerAmount.BigInt()),
 		"side":          (*math.HexOrDecimal256)(big.NewInt(int64(sideInt))),
-		"signatureType": (*math.HexOrDecimal256)(big.NewInt(int64(sigTypeVal))),
+		"signatureType": (*math.HexOrDecimal256)(big.NewInt(int64(orderSignatureType(order)))),
 		"timestamp":     (*math.HexOrDecimal256)(big.NewInt(order.Timestamp)),
 		"metadata":      padBytes32(order.Metadata),
 		"builder":       padBytes32(order.Builder),
 	}
 
-	sig, err := signer.SignTypedData(domain, typesDef, message, "Order")
-	if err != nil {
-		return nil, fmt.Errorf("signing failed: %w", err)
-	}
+	return apitypes.TypedData{
+		Types:       OrderTypes(),
+		PrimaryType: "Order",
+		Domain:      domain,
+		Message:     message,
+	}, nil
+}
 
-	owner := apiKey.Key
-	if owner == "" {
-		owner = signer.Address().String()
+const (
+	// ExchangeDomainName and ExchangeDomainVersion identify the EIP-712
+	// domain orders are signed for.
+	ExchangeDomainName    = "Polymarket CTF Exchange"
+	ExchangeDomainVersion = "2"
+	// ExchangeV2Address is the V2 CTF Exchange (Mainnet) that verifies order
+	// signatures.
+	ExchangeV2Address = "0xE111180000d2663C0091e4f400237545B87B996B"
+)
+
+// OrderTypes returns a copy of the EIP-712 types used to sign orders.
+func OrderTypes() apitypes.Types {
+	return apitypes.Types{
+		"EIP712Domain": {
+			{Name: "name", Type: "string"},
+			{Name: "version", Type: "string"},
+			{Name: "chainId", Type: "uint256"},
+			{Name: "verifyingContract", Type: "address"},
+		},
+		"Order": append([]apitypes.Type(nil), orderTypeFields...),
 	}
+}
 
-	return &clobtypes.SignedOrder{
-		Order:     *order,
-		Signature: hexutil.Encode(sig),
-		Owner:     owner,
-	}, nil
+// orderTypeFields is the EIP-712 definition of the V2 Order struct.
+var orderTypeFields = []apitypes.Type{
+	{Name: "salt", Type: "uint256"},
+	{Name: "maker", Type: "address"},
+	{Name: "signer", Type: "address"},
+	{Name: "tokenId", Type: "uint256"},
+	{Name: "makerAmount", Type: "uint256"},
+	{Name: "takerAmount", Type: "uint256"},
+	{Name: "side", Type: "uint8"},
+	{Name: "signatureType", Type: "uint8"},
+	{Name: "timestamp", Type: "uint256"},
+	{Name: "metadata", Type: "bytes32"},
+	{Name: "builder", Type: "bytes32"},
 }
 
 func (c *clientImpl) PostOrder(ctx context.Context, req *clobtypes.SignedOrder) (clobtypes.OrderResponse, error) {
 	var resp clobtypes.OrderResponse
+	if req != nil {
+		if err := c.checkEligibility(req.Order.Side); err != nil {
+			return resp, err
+		}
+	}
 	payload, err := buildOrderPayload(req)
 	if err != nil {
 		return resp, err
@@ -236,6 +292,13 @@ func (c *clientImpl) PostOrders(ctx context.Context, req *clobtypes.SignedOrders
 	if req != nil && len(req.Orders) > clobtypes.MaxPostOrdersBatchSize {
 		return resp, fmt.Errorf("batch size %d exceeds maximum of %d orders", len(req.Orders), clobtypes.MaxPostOrdersBatchSize)
 	}
+	if req != nil {
+		for i := range req.Orders {
+			if err := c.checkEligibility(req.Orders[i].Order.Side); err != nil {
+				return resp, fmt.Errorf("order %d: %w", i, err)
+			}
+		}
+	}
 	payload, err := buildOrdersPayload(req)
 	if err != nil {
 		return resp, err
@@ -0,0 +1,150 @@
+package clob
+
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+// cursorIter walks a CLOB cursor endpoint. The request's own limit is used
+// unless a page size is given, and the EndCursor sentinel ends the walk.
+func cursorIter[T any](ctx context.Context, start string, limit int, opts []paginate.Option, fetch paginate.CursorFetch[T]) iter.Seq2[T, error] {
+	o := paginate.Apply(opts...)
+	if start == "" || start == clobtypes.EndCursor {
+		start = clobtypes.InitialCursor
+	}
+	return paginate.Cursor(ctx, start, o.Size(limit, 0), o, func(ctx context.Context, cursor string, limit int) ([]T, string, error) {
+		items, next, err := fetch(ctx, cursor, limit)
+		if next == clobtypes.EndCursor {
+			next = ""
+		}
+		return items, next, err
+	})
+}
+
+func (c *clientImpl) marketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts []paginate.Option, page func(context.Context, *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)) iter.Seq2[clobtypes.Market, error] {
+	base := clobtypes.MarketsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return cursorIter(ctx, base.Cursor, base.Limit, opts, func(ctx context.Context, cursor string, limit int) ([]clobtypes.Market, string, error) {
+		nextReq := base
+		nextReq.Cursor = cursor
+		nextReq.Limit = limit
+		resp, err := page(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+func (c *clientImpl) MarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error] {
+	return c.marketsIter(ctx, req, opts, c.Markets)
+}
+
+func (c *clientImpl) SimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error] {
+	return c.marketsIter(ctx, req, opts, c.SimplifiedMarkets)
+}
+
+func (c *clientImpl) SamplingMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error] {
+	return c.marketsIter(ctx, req, opts, c.SamplingMarkets)
+}
+
+func (c *clientImpl) SamplingSimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error] {
+	return c.marketsIter(ctx, req, opts, c.SamplingSimplifiedMarkets)
+}
+
+func (c *clientImpl) OrdersIter(ctx context.Context, req *clobtypes.OrdersRequest, opts ...paginate.Option) iter.Seq2[clobtypes.OrderResponse, error] {
+	base := clobtypes.OrdersRequest{}
+	if req != nil {
+		base = *req
+	}
+	start := base.NextCursor
+	if start == "" {
+		start = base.Cursor
+	}
+	return cursorIter(ctx, start, base.Limit, opts, func(ctx context.Context, cursor string, limit int) ([]clobtypes.OrderResponse, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		nextReq.Limit = limit
+		resp, err := c.Orders(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+func (c *clientImpl) TradesIter(ctx context.Context, req *clobtypes.TradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error] {
+	base := clobtypes.TradesRequest{}
+	if req != nil {
+		base = *req
+	}
+	start := base.NextCursor
+	if start == "" {
+		start = base.Cursor
+	}
+	return cursorIter(ctx, start, base.Limit, opts, func(ctx context.Context, cursor string, limit int) ([]clobtypes.Trade, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		nextReq.Limit = limit
+		resp, err := c.Trades(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+func (c *clientImpl) BuilderTradesIter(ctx context.Context, req *clobtypes.BuilderTradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error] {
+	base := clobtypes.BuilderTradesRequest{}
+	if req != nil {
+		base = *req
+	}
+	start := base.NextCursor
+	if start == "" {
+		start = base.Cursor
+	}
+	return cursorIter(ctx, start, base.Limit, opts, func(ctx context.Context, cursor string, limit int) ([]clobtypes.Trade, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		nextReq.Limit = limit
+		resp, err := c.BuilderTrades(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+// The rewards endpoints choose their own page size, so the size hint is ignored.
+
+func (c *clientImpl) UserEarningsIter(ctx context.Context, req *clobtypes.UserEarningsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.UserEarning, error] {
+	base := clobtypes.UserEarningsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return cursorIter(ctx, base.NextCursor, 0, opts, func(ctx context.Context, cursor string, _ int) ([]clobtypes.UserEarning, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		resp, err := c.UserEarnings(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+func (c *clientImpl) RewardsMarketsCurrentIter(ctx context.Context, req *clobtypes.RewardsMarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.CurrentReward, error] {
+	base := clobtypes.RewardsMarketsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return cursorIter(ctx, base.NextCursor, 0, opts, func(ctx context.Context, cursor string, _ int) ([]clobtypes.CurrentReward, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		resp, err := c.RewardsMarketsCurrent(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
+
+func (c *clientImpl) RewardsMarketsIter(ctx context.Context, req *clobtypes.RewardsMarketRequest, opts ...paginate.Option) iter.Seq2[clobtypes.MarketReward, error] {
+	base := clobtypes.RewardsMarketRequest{}
+	if req != nil {
+		base = *req
+	}
+	return cursorIter(ctx, base.NextCursor, 0, opts, func(ctx context.Context, cursor string, _ int) ([]clobtypes.MarketReward, string, error) {
+		nextReq := base
+		nextReq.NextCursor = cursor
+		resp, err := c.RewardsMarkets(ctx, &nextReq)
+		return resp.Data, resp.NextCursor, err
+	})
+}
@@ -0,0 +1,275 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
+)
+
+const (
+	defaultNotificationMinInterval = 5 * time.Second
+	defaultNotificationMaxInterval = time.Minute
+	defaultNotificationBuffer      = 100
+	defaultNotificationSeenTTL     = 48 * time.Hour
+	notificationFlushTimeout       = 5 * time.Second
+)
+
+// ErrWatcherStarted is returned when a NotificationWatcher is started twice.
+var ErrWatcherStarted = errors.New("notification watcher already started")
+
+// NotificationsAPI is the part of Client used by NotificationWatcher.
+type NotificationsAPI interface {
+	Notifications(ctx context.Context, req *clobtypes.NotificationsRequest) (clobtypes.NotificationsResponse, error)
+	DropNotifications(ctx context.Context, req *clobtypes.DropNotificationsRequest) (clobtypes.DropNotificationsResponse, error)
+}
+
+// NotificationWatcherConfig controls polling of account notifications.
+type NotificationWatcherConfig struct {
+	// MinInterval is the polling interval while notifications keep arriving.
+	// Defaults to 5 seconds.
+	MinInterval time.Duration
+	// MaxInterval caps the interval, which doubles after every poll that
+	// returns nothing new. Defaults to one minute.
+	MaxInterval time.Duration
+	// Limit is passed to the notifications endpoint; zero leaves the API default.
+	Limit int
+	// Buffer is the capacity of the event channel. Defaults to 100.
+	Buffer int
+	// SeenTTL is how long a delivered but unacknowledged notification is
+	// remembered to suppress redelivery. Defaults to 48 hours.
+	SeenTTL time.Duration
+}
+
+// NotificationEvent is a new notification with its payload decoded by type.
+// At most one of Fill, Cancellation and Resolution is set.
+type NotificationEvent struct {
+	clobtypes.Notification
+	Fill         *clobtypes.OrderNotification
+	Cancellation *clobtypes.OrderNotification
+	Resolution   *clobtypes.ResolutionNotification
+
+	watcher *NotificationWatcher
+}
+
+// Ack acknowledges the notification; it is dropped on the next poll.
+func (e NotificationEvent) Ack() {
+	if e.watcher != nil {
+		e.watcher.Ack(e.ID)
+	}
+}
+
+// NotificationWatcher polls account notifications, delivering each one once
+// on a stream and dropping acknowledged notifications from the account.
+// It lets bots react to fills and cancellations without a user WS connection.
+type NotificationWatcher struct {
+	client NotificationsAPI
+	cfg    NotificationWatcherConfig
+
+	mu      sync.Mutex
+	started bool
+	acks    []string
+	// seen maps delivered IDs to when they were first delivered. IDs are
+	// forgotten once dropped or after cfg.SeenTTL, not when a poll omits
+	// them, since a limited page does not return every pending notification.
+	seen map[string]time.Time
+	now  func() time.Time
+}
+
+// NewNotificationWatcher creates a watcher polling client.
+func NewNotificationWatcher(client NotificationsAPI, cfg NotificationWatcherConfig) *NotificationWatcher {
+	if cfg.MinInterval <= 0 {
+		cfg.MinInterval = defaultNotificationMinInterval
+	}
+	if cfg.MaxInterval < cfg.MinInterval {
+		cfg.MaxInterval = max(defaultNotificationMaxInterval, cfg.MinInterval)
+	}
+	if cfg.Buffer <= 0 {
+		cfg.Buffer = defaultNotificationBuffer
+	}
+	if cfg.SeenTTL <= 0 {
+		cfg.SeenTTL = defaultNotificationSeenTTL
+	}
+	return &NotificationWatcher{client: client, cfg: cfg, seen: make(map[string]time.Time), now: time.Now}
+}
+
+// Ack acknowledges notifications by ID. They are dropped before the next
+// poll and when the stream is closed.
+func (w *NotificationWatcher) Ack(ids ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, id := range ids {
+		if id != "" {
+			w.acks = append(w.acks, id)
+		}
+	}
+}
+
+// Start begins polling and returns the stream of new notifications. Poll,
+// decode and drop failures are reported on the stream's Err channel; polling
+// continues until the stream is closed or ctx is done.
+func (w *NotificationWatcher) Start(ctx context.Context) (*stream.Stream[NotificationEvent], error) {
+	w.mu.Lock()
+	if w.started {
+		w.mu.Unlock()
+		return nil, ErrWatcherStarted
+	}
+	w.started = true
+	w.mu.Unlock()
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan NotificationEvent, w.cfg.Buffer)
+	errs := make(chan error, 10)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.run(ctx, out, errs)
+	}()
+	return stream.New(out, errs, func() error {
+		cancel()
+		<-done
+		return nil
+	}), nil
+}
+
+func (w *NotificationWatcher) run(ctx context.Context, out chan<- NotificationEvent, errs chan<- error) {
+	defer close(errs)
+	defer close(out)
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), notificationFlushTimeout)
+		defer cancel()
+		if err := w.dropAcked(flushCtx); err != nil {
+			reportErr(errs, err)
+		}
+	}()
+
+	interval := w.cfg.MinInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if err := w.dropAcked(ctx); err != nil && ctx.Err() == nil {
+			reportErr(errs, err)
+		}
+		delivered, err := w.poll(ctx, out, errs)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			reportErr(errs, err)
+		}
+		if delivered > 0 {
+			interval = w.cfg.MinInterval
+		} else {
+			interval = min(interval*2, w.cfg.MaxInterval)
+		}
+		timer.Reset(interval)
+	}
+}
+
+// poll fetches notifications and delivers the ones not seen before.
+func (w *NotificationWatcher) poll(ctx context.Context, out chan<- NotificationEvent, errs chan<- error) (int, error) {
+	var req *clobtypes.NotificationsRequest
+	if w.cfg.Limit > 0 {
+		req = &clobtypes.NotificationsRequest{Limit: w.cfg.Limit}
+	}
+	resp, err := w.client.Notifications(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+
+	now := w.now()
+	w.mu.Lock()
+	for id, at := range w.seen {
+		if now.Sub(at) >= w.cfg.SeenTTL {
+			delete(w.seen, id)
+		}
+	}
+	w.mu.Unlock()
+	delivered := 0
+	for _, n := range resp {
+		w.mu.Lock()
+		_, ok := w.seen[n.ID]
+		w.mu.Unlock()
+		if ok {
+			continue
+		}
+		event, err := w.decode(n)
+		if err != nil {
+			reportErr(errs, err)
+		}
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return delivered, ctx.Err()
+		}
+		w.mu.Lock()
+		w.seen[n.ID] = now
+		w.mu.Unlock()
+		delivered++
+	}
+	return delivered, nil
+}
+
+func (w *NotificationWatcher) decode(n clobtypes.Notification) (NotificationEvent, error) {
+	event := NotificationEvent{Notification: n, watcher: w}
+	switch n.Type {
+	case clobtypes.NotificationOrderFilled, clobtypes.NotificationOrderCancelled:
+		p, err := n.OrderPayload()
+		if err != nil {
+			return event, err
+		}
+		if n.Type == clobtypes.NotificationOrderFilled {
+			event.Fill = &p
+		} else {
+			event.Cancellation = &p
+		}
+	case clobtypes.NotificationMarketResolved:
+		p, err := n.ResolutionPayload()
+		if err != nil {
+			return event, err
+		}
+		event.Resolution = &p
+	}
+	return event, nil
+}
+
+// dropAcked drops acknowledged notifications. Failed IDs are retried later.
+func (w *NotificationWatcher) dropAcked(ctx context.Context) error {
+	w.mu.Lock()
+	ids := w.acks
+	w.acks = nil
+	w.mu.Unlock()
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := w.client.DropNotifications(ctx, &clobtypes.DropNotificationsRequest{IDs: ids}); err != nil {
+		w.mu.Lock()
+		w.acks = append(ids, w.acks...)
+		w.mu.Unlock()
+		return err
+	}
+	w.mu.Lock()
+	for _, id := range ids {
+		delete(w.seen, id)
+	}
+	w.mu.Unlock()
+	return nil
+}
+
+func reportErr(errs chan<- error, err error) {
+	select {
+	case errs <- err:
+	default:
+	}
+}
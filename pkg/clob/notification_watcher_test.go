@@ -0,0 +1,197 @@
+package clob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+)
+
+type fakeNotifications struct {
+	mu      sync.Mutex
+	pending []clobtypes.Notification
+	dropped []string
+	polls   int
+	dropErr error
+}
+
+func (f *fakeNotifications) Notifications(ctx context.Context, req *clobtypes.NotificationsRequest) (clobtypes.NotificationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.polls++
+	return append(clobtypes.NotificationsResponse(nil), f.pending...), nil
+}
+
+func (f *fakeNotifications) DropNotifications(ctx context.Context, req *clobtypes.DropNotificationsRequest) (clobtypes.DropNotificationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.dropErr != nil {
+		err := f.dropErr
+		f.dropErr = nil
+		return clobtypes.DropNotificationsResponse{}, err
+	}
+	drop := make(map[string]bool)
+	for _, id := range req.IDs {
+		drop[id] = true
+		f.dropped = append(f.dropped, id)
+	}
+	kept := f.pending[:0]
+	for _, n := range f.pending {
+		if !drop[n.ID] {
+			kept = append(kept, n)
+		}
+	}
+	f.pending = kept
+	return clobtypes.DropNotificationsResponse{Status: "OK"}, nil
+}
+
+func (f *fakeNotifications) add(n clobtypes.Notification) {
+	f.mu.Lock()
+	f.pending = append(f.pending, n)
+	f.mu.Unlock()
+}
+
+func (f *fakeNotifications) droppedIDs() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.dropped...)
+}
+
+func decodeNotification(t *testing.T, raw string) clobtypes.Notification {
+	t.Helper()
+	var n clobtypes.Notification
+	if err := json.Unmarshal([]byte(raw), &n); err != nil {
+		t.Fatalf("decode notification: %v", err)
+	}
+	return n
+}
+
+func nextEvent(t *testing.T, c <-chan NotificationEvent) NotificationEvent {
+	t.Helper()
+	select {
+	case e := <-c:
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for notification")
+		return NotificationEvent{}
+	}
+}
+
+func TestNotificationWatcherDedupesAndDropsOnAck(t *testing.T) {
+	api := &fakeNotifications{}
+	api.add(decodeNotification(t, `{"id":101,"type":2,"owner":"k","timestamp":1700000000,"payload":{"order_id":"0xabc","asset_id":"tok","side":"BUY","price":"0.52","matched_size":10}}`))
+	api.add(decodeNotification(t, `{"id":"102","type":"4","payload":{"condition_id":"0xcond","outcome":"Yes"}}`))
+
+	w := NewNotificationWatcher(api, NotificationWatcherConfig{MinInterval: 5 * time.Millisecond, MaxInterval: 10 * time.Millisecond})
+	s, err := w.Start(context.Background())
+	if err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	if _, err := w.Start(context.Background()); !errors.Is(err, ErrWatcherStarted) {
+		t.Fatalf("expected ErrWatcherStarted, got %v", err)
+	}
+
+	fill := nextEvent(t, s.C)
+	if fill.ID != "101" || fill.Fill == nil || fill.Fill.OrderID != "0xabc" || fill.Fill.MatchedSize != "10" || fill.Timestamp != "1700000000" {
+		t.Fatalf("unexpected fill event %+v", fill)
+	}
+	resolution := nextEvent(t, s.C)
+	if resolution.Resolution == nil || resolution.Resolution.Outcome != "Yes" {
+		t.Fatalf("unexpected resolution event %+v", resolution)
+	}
+
+	// Unacknowledged notifications stay on the account but are not redelivered.
+	api.add(decodeNotification(t, `{"id":"103","type":1,"payload":{"order_id":"0xdef"}}`))
+	cancel := nextEvent(t, s.C)
+	if cancel.ID != "103" || cancel.Cancellation == nil || cancel.Cancellation.OrderID != "0xdef" {
+		t.Fatalf("unexpected cancel event %+v", cancel)
+	}
+
+	fill.Ack()
+	deadline := time.Now().Add(2 * time.Second)
+	for len(api.droppedIDs()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := api.droppedIDs(); len(got) != 1 || got[0] != "101" {
+		t.Fatalf("expected 101 to be dropped, got %v", got)
+	}
+
+	// Acks made right before closing are flushed on shutdown.
+	w.Ack("102")
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	for range s.C {
+		t.Fatal("unexpected redelivery")
+	}
+	if got := api.droppedIDs(); len(got) != 2 || got[1] != "102" {
+		t.Fatalf("expected 102 to be dropped on close, got %v", got)
+	}
+}
+
+func TestNotificationWatcherRetriesFailedDrop(t *testing.T) {
+	api := &fakeNotifications{dropErr: errors.New("unavailable")}
+	w := NewNotificationWatcher(api, NotificationWatcherConfig{})
+	w.Ack("1", "2")
+	if err := w.dropAcked(context.Background()); err == nil {
+		t.Fatal("expected drop error")
+	}
+	if err := w.dropAcked(context.Background()); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if got := api.droppedIDs(); len(got) != 2 {
+		t.Fatalf("expected both IDs dropped on retry, got %v", got)
+	}
+}
+
+func TestNotificationWatcherRemembersIDsOutsidePage(t *testing.T) {
+	api := &fakeNotifications{}
+	w := NewNotificationWatcher(api, NotificationWatcherConfig{Limit: 2})
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+	out := make(chan NotificationEvent, 10)
+	errs := make(chan error, 10)
+	ctx := context.Background()
+	poll := func(ids ...string) int {
+		t.Helper()
+		api.mu.Lock()
+		api.pending = nil
+		for _, id := range ids {
+			api.pending = append(api.pending, clobtypes.Notification{ID: id})
+		}
+		api.mu.Unlock()
+		n, err := w.poll(ctx, out, errs)
+		if err != nil {
+			t.Fatalf("poll: %v", err)
+		}
+		return n
+	}
+
+	if n := poll("1", "2"); n != 2 {
+		t.Fatalf("expected 2 deliveries, got %d", n)
+	}
+	// A page without 1 and 2 must not make the watcher forget them.
+	if n := poll("3"); n != 1 {
+		t.Fatalf("expected 1 delivery, got %d", n)
+	}
+	if n := poll("1", "2"); n != 0 {
+		t.Fatalf("expected no redelivery, got %d", n)
+	}
+
+	// Dropped IDs are forgotten, and so are stale ones after SeenTTL.
+	w.Ack("1")
+	if err := w.dropAcked(ctx); err != nil {
+		t.Fatalf("dropAcked: %v", err)
+	}
+	if _, ok := w.seen["1"]; ok {
+		t.Fatal("expected dropped ID to be forgotten")
+	}
+	now = now.Add(defaultNotificationSeenTTL)
+	if n := poll("2"); n != 1 {
+		t.Fatalf("expected redelivery after SeenTTL, got %d", n)
+	}
+}
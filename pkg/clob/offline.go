@@ -0,0 +1,236 @@
+package clob
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+)
+
+// UnsignedOrderVersion is the format version written by ExportUnsigned.
+const UnsignedOrderVersion = 1
+
+// UnsignedOrder is a fully resolved order exported for signing on another
+// machine. TypedData is what the key signs; for POLY_1271 orders it is the
+// ERC-7739 TypedDataSign wrapper of the order. Digest is its EIP-712 hash.
+type UnsignedOrder struct {
+	Version   int                 `json:"version"`
+	Order     clobtypes.Order     `json:"order"`
+	OrderType clobtypes.OrderType `json:"orderType,omitempty"`
+	PostOnly  *bool               `json:"postOnly,omitempty"`
+	// Owner is the API key the order is posted under.
+	Owner string `json:"owner"`
+	// SignerAddress is the key expected to sign the order.
+	SignerAddress common.Address     `json:"signerAddress"`
+	TypedData     apitypes.TypedData `json:"typedData"`
+	Digest        hexutil.Bytes      `json:"digest"`
+}
+
+// ExportUnsigned builds the order and exports it unsigned, to be posted under
+// owner. Market orders are built when AmountUSDC or AmountShares was set. The
+// builder's signer only needs to know its address; see auth.NewWatchOnlySigner.
+func (b *OrderBuilder) ExportUnsigned(ctx context.Context, owner string) (*UnsignedOrder, error) {
+	var (
+		signable *clobtypes.SignableOrder
+		err      error
+	)
+	if b.amount != nil {
+		signable, err = b.BuildMarketWithContext(ctx)
+	} else {
+		signable, err = b.BuildSignableWithContext(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return NewUnsignedOrder(b.signer, owner, signable)
+}
+
+// NewUnsignedOrder resolves order for signer and exports it unsigned. Only the
+// signer's address and chain ID are used.
+func NewUnsignedOrder(signer auth.Signer, owner string, order *clobtypes.SignableOrder) (*UnsignedOrder, error) {
+	if signer == nil {
+		return nil, auth.ErrMissingSigner
+	}
+	if order == nil || order.Order == nil {
+		return nil, fmt.Errorf("order is required")
+	}
+	resolved := *order.Order
+	if err := resolveOrder(signer, &resolved, nil, nil, nil); err != nil {
+		return nil, err
+	}
+	if owner == "" {
+		owner = signer.Address().String()
+	}
+	typedData, digest, err := unsignedOrderTypedData(&resolved, signer.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	return &UnsignedOrder{
+		Version:       UnsignedOrderVersion,
+		Order:         resolved,
+		OrderType:     order.OrderType,
+		PostOnly:      order.PostOnly,
+		Owner:         owner,
+		SignerAddress: signer.Address(),
+		TypedData:     typedData,
+		Digest:        digest,
+	}, nil
+}
+
+// Verify checks that the typed data and digest match the order, so a
+// tampered file cannot make the key sign something other than the order.
+func (u *UnsignedOrder) Verify() error {
+	if u == nil {
+		return fmt.Errorf("unsigned order is required")
+	}
+	if u.Version != UnsignedOrderVersion {
+		return fmt.Errorf("unsupported unsigned order version %d", u.Version)
+	}
+	if u.TypedData.Domain.ChainId == nil {
+		return fmt.Errorf("unsigned order has no chain ID")
+	}
+	_, want, err := unsignedOrderTypedData(&u.Order, (*big.Int)(u.TypedData.Domain.ChainId))
+	if err != nil {
+		return err
+	}
+	got, _, err := apitypes.TypedDataAndHash(u.TypedData)
+	if err != nil {
+		return fmt.Errorf("hash typed data: %w", err)
+	}
+	if !bytes.Equal(got, want) || !bytes.Equal(u.Digest, want) {
+		return fmt.Errorf("typed data does not match order")
+	}
+	return nil
+}
+
+// SignUnsignedOrder verifies u and signs its typed data with signer, returning
+// the detached 65-byte signature as hex. It is meant for the offline machine.
+func SignUnsignedOrder(signer auth.Signer, u *UnsignedOrder) (string, error) {
+	if signer == nil {
+		return "", auth.ErrMissingSigner
+	}
+	if err := u.Verify(); err != nil {
+		return "", err
+	}
+	if signer.Address() != u.SignerAddress {
+		return "", fmt.Errorf("signer %s does not match expected %s", signer.Address().Hex(), u.SignerAddress.Hex())
+	}
+	td := u.TypedData
+	sig, err := signer.SignTypedData(&td.Domain, td.Types, td.Message, td.PrimaryType)
+	if err != nil {
+		return "", fmt.Errorf("signing failed: %w", err)
+	}
+	return hexutil.Encode(sig), nil
+}
+
+// ImportSignature attaches a detached signature to u. The signature must
+// recover to u.SignerAddress. POLY_1271 signatures are wrapped in the layout
+// the deposit wallet expects. The result is ready for PostOrder.
+func ImportSignature(u *UnsignedOrder, signature string) (*clobtypes.SignedOrder, error) {
+	if err := u.Verify(); err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), "0x"))
+	if err != nil || len(sig) != 65 {
+		return nil, fmt.Errorf("%w: expected 65-byte hex signature", sdkerrors.ErrInvalidSignature)
+	}
+	recovered, err := recoverAddress(u.Digest, sig)
+	if err != nil {
+		return nil, err
+	}
+	if recovered != u.SignerAddress {
+		return nil, fmt.Errorf("%w: signature recovers to %s, expected %s", sdkerrors.ErrInvalidSignature, recovered.Hex(), u.SignerAddress.Hex())
+	}
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+
+	encoded := hexutil.Encode(sig)
+	if orderSignatureType(&u.Order) == int(auth.SignaturePoly1271) {
+		_, domainSeparator, contentsHash, err := poly1271OrderDigest(&u.Order, (*big.Int)(u.TypedData.Domain.ChainId))
+		if err != nil {
+			return nil, err
+		}
+		encoded = wrapPoly1271Signature(sig, domainSeparator, contentsHash)
+	}
+	return &clobtypes.SignedOrder{
+		Order:     u.Order,
+		Signature: encoded,
+		Owner:     u.Owner,
+		OrderType: u.OrderType,
+		PostOnly:  u.PostOnly,
+	}, nil
+}
+
+// unsignedOrderTypedData returns the typed data the key signs for order and
+// its digest.
+func unsignedOrderTypedData(order *clobtypes.Order, chainID *big.Int) (apitypes.TypedData, []byte, error) {
+	var (
+		td  apitypes.TypedData
+		err error
+	)
+	if orderSignatureType(order) == int(auth.SignaturePoly1271) {
+		td, err = poly1271TypedData(order, chainID)
+	} else {
+		td, err = orderTypedData(order, chainID)
+	}
+	if err != nil {
+		return td, nil, err
+	}
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		return td, nil, fmt.Errorf("hash typed data: %w", err)
+	}
+	return td, digest, nil
+}
+
+// poly1271TypedData expresses the POLY_1271 TypedDataSign digest as EIP-712
+// typed data, so wallets that only sign typed data can sign it.
+func poly1271TypedData(order *clobtypes.Order, chainID *big.Int) (apitypes.TypedData, error) {
+	inner, err := orderTypedData(order, chainID)
+	if err != nil {
+		return inner, err
+	}
+	inner.Types["TypedDataSign"] = []apitypes.Type{
+		{Name: "contents", Type: "Order"},
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+		{Name: "salt", Type: "bytes32"},
+	}
+	inner.PrimaryType = "TypedDataSign"
+	inner.Message = apitypes.TypedDataMessage{
+		"contents":          map[string]interface{}(inner.Message),
+		"name":              poly1271DepositWalletName,
+		"version":           poly1271DepositWalletVersion,
+		"chainId":           (*math.HexOrDecimal256)(chainID),
+		"verifyingContract": order.Signer.String(),
+		"salt":              poly1271Bytes32Zero,
+	}
+	return inner, nil
+}
+
+func recoverAddress(digest, sig []byte) (common.Address, error) {
+	recoverable := append([]byte(nil), sig...)
+	if recoverable[64] >= 27 {
+		recoverable[64] -= 27
+	}
+	pub, err := crypto.SigToPub(digest, recoverable)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", sdkerrors.ErrInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
@@ -0,0 +1,132 @@
+package clob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+// roundTrip simulates carrying the unsigned order to the offline machine.
+func roundTrip(t *testing.T, u *UnsignedOrder) *UnsignedOrder {
+	t.Helper()
+	raw, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("marshal unsigned order: %v", err)
+	}
+	var out UnsignedOrder
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatalf("unmarshal unsigned order: %v", err)
+	}
+	return &out
+}
+
+func TestOfflineSigningMatchesOnlineSigning(t *testing.T) {
+	stub := newStubClient()
+	stub.tickSize = 0.01
+	stub.feeRate = 0
+	stub.clientImpl.saltGenerator = func() (*big.Int, error) { return big.NewInt(7), nil }
+
+	key := mustSigner(t)
+	watchOnly := auth.NewWatchOnlySigner(key.Address(), 137)
+	exported, err := NewOrderBuilder(stub, watchOnly).
+		TokenID("123").
+		Side("BUY").
+		Price(0.5).
+		Size(10).
+		OrderType(clobtypes.OrderTypeGTC).
+		ExportUnsigned(context.Background(), "api-key")
+	if err != nil {
+		t.Fatalf("ExportUnsigned: %v", err)
+	}
+
+	offline := roundTrip(t, exported)
+	sig, err := SignUnsignedOrder(key, offline)
+	if err != nil {
+		t.Fatalf("SignUnsignedOrder: %v", err)
+	}
+	signed, err := ImportSignature(roundTrip(t, exported), sig)
+	if err != nil {
+		t.Fatalf("ImportSignature: %v", err)
+	}
+
+	order := exported.Order
+	online, err := SignOrder(key, &auth.APIKey{Key: "api-key"}, &order)
+	if err != nil {
+		t.Fatalf("SignOrder: %v", err)
+	}
+	if signed.Signature != online.Signature || signed.Owner != "api-key" || signed.OrderType != clobtypes.OrderTypeGTC {
+		t.Fatalf("offline order differs from online order:\n%+v\n%+v", signed, online)
+	}
+
+	other, _ := auth.NewPrivateKeySigner("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", 137)
+	if _, err := SignUnsignedOrder(other, offline); err == nil {
+		t.Fatal("expected signer mismatch")
+	}
+	foreign, _ := other.SignTypedData(&offline.TypedData.Domain, offline.TypedData.Types, offline.TypedData.Message, offline.TypedData.PrimaryType)
+	if _, err := ImportSignature(offline, common.Bytes2Hex(foreign)); !errors.Is(err, sdkerrors.ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+
+	tampered := roundTrip(t, exported)
+	tampered.Order.MakerAmount = decimal.NewFromInt(1)
+	if _, err := SignUnsignedOrder(key, tampered); err == nil {
+		t.Fatal("expected tampered order to be rejected")
+	}
+}
+
+func TestOfflineSigningPoly1271(t *testing.T) {
+	key, _ := auth.NewPrivateKeySigner("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", 137)
+	tokenID, _ := new(big.Int).SetString("1000000000000000000000000000000000000000000000000000000000000001", 10)
+	funder := common.HexToAddress("0x9c90cad21cb08320Fb224EAb032dDAE311c017Ef")
+	sigType := int(auth.SignaturePoly1271)
+	order := &clobtypes.Order{
+		Salt:          types.U256{Int: big.NewInt(123)},
+		Maker:         funder,
+		Signer:        funder,
+		TokenID:       types.U256{Int: tokenID},
+		MakerAmount:   decimal.NewFromInt(9878920),
+		TakerAmount:   decimal.NewFromInt(20120000),
+		Expiration:    types.U256{Int: big.NewInt(1700000000)},
+		Side:          "BUY",
+		SignatureType: &sigType,
+		Timestamp:     1700000000123,
+	}
+
+	exported, err := NewUnsignedOrder(auth.NewWatchOnlySigner(key.Address(), 137), "api-key", &clobtypes.SignableOrder{Order: order})
+	if err != nil {
+		t.Fatalf("NewUnsignedOrder: %v", err)
+	}
+	digest, _, _, err := poly1271OrderDigest(order, big.NewInt(137))
+	if err != nil {
+		t.Fatalf("poly1271OrderDigest: %v", err)
+	}
+	if common.Bytes2Hex(exported.Digest) != common.Bytes2Hex(digest) {
+		t.Fatal("TypedDataSign typed data does not hash to the POLY_1271 digest")
+	}
+
+	sig, err := SignUnsignedOrder(key, roundTrip(t, exported))
+	if err != nil {
+		t.Fatalf("SignUnsignedOrder: %v", err)
+	}
+	signed, err := ImportSignature(exported, sig)
+	if err != nil {
+		t.Fatalf("ImportSignature: %v", err)
+	}
+	online, err := SignOrder(key, &auth.APIKey{Key: "api-key"}, order)
+	if err != nil {
+		t.Fatalf("SignOrder: %v", err)
+	}
+	if signed.Signature != online.Signature {
+		t.Fatalf("wrapped signature mismatch:\ngot  %s\nwant %s", signed.Signature, online.Signature)
+	}
+}
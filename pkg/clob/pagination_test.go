@@ -3,9 +3,11 @@ package clob
 import (
 	"context"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
 )
 
@@ -92,3 +94,47 @@ func TestMarketsAllPagination(t *testing.T) {
 		t.Fatalf("expected 2 markets, got %d", len(results))
 	}
 }
+
+func TestTradesIterResumesAndStopsEarly(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			buildKey("/data/trades", url.Values{"limit": {"2"}, "next_cursor": {"NEXT"}}):  `{"data":[{"id":"3"},{"id":"4"}],"next_cursor":"LAST"}`,
+			buildKey("/data/trades", url.Values{"limit": {"2"}, "next_cursor": {"LAST"}}):  `{"data":[{"id":"5"}],"next_cursor":"LTE="}`,
+			buildKey("/data/trades", url.Values{"limit": {"2"}, "next_cursor": {"MA=="}}): `{"data":[{"id":"1"},{"id":"2"}],"next_cursor":"NEXT"}`,
+		},
+	}
+	client := &clientImpl{
+		httpClient: transport.NewClient(doer, "http://example"),
+		cache:      newClientCache(),
+	}
+
+	var ids []string
+	var resume string
+	onPage := paginate.WithOnPage(func(p paginate.Page) { resume = p.NextCursor })
+	for trade, err := range client.TradesIter(context.Background(), &clobtypes.TradesRequest{Limit: 5}, paginate.WithPageSize(2), paginate.WithCursor("NEXT"), onPage) {
+		if err != nil {
+			t.Fatalf("TradesIter failed: %v", err)
+		}
+		ids = append(ids, trade.ID)
+	}
+	if strings.Join(ids, ",") != "3,4,5" {
+		t.Fatalf("unexpected trades %v", ids)
+	}
+	if resume != "" {
+		t.Fatalf("expected empty resume cursor at end, got %q", resume)
+	}
+
+	ids = nil
+	for trade, err := range client.TradesIter(context.Background(), &clobtypes.TradesRequest{Limit: 2}, onPage) {
+		if err != nil {
+			t.Fatalf("TradesIter failed: %v", err)
+		}
+		ids = append(ids, trade.ID)
+		if len(ids) == 2 {
+			break
+		}
+	}
+	if strings.Join(ids, ",") != "1,2" || resume != "NEXT" {
+		t.Fatalf("early break: trades %v resume %q", ids, resume)
+	}
+}
@@ -15,13 +15,13 @@ import (
 )
 
 const (
-	poly1271ExchangeV2Address     = "0xE111180000d2663C0091e4f400237545B87B996B"
+	poly1271ExchangeV2Address     = ExchangeV2Address
 	poly1271Bytes32Zero           = "0x0000000000000000000000000000000000000000000000000000000000000000"
 	poly1271EIP712DomainType      = "EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"
 	poly1271OrderType             = "Order(uint256 salt,address maker,address signer,uint256 tokenId,uint256 makerAmount,uint256 takerAmount,uint8 side,uint8 signatureType,uint256 timestamp,bytes32 metadata,bytes32 builder)"
 	poly1271TypedDataSignType     = "TypedDataSign(Order contents,string name,string version,uint256 chainId,address verifyingContract,bytes32 salt)" + poly1271OrderType
-	poly1271ExchangeDomainName    = "Polymarket CTF Exchange"
-	poly1271ExchangeDomainVersion = "2"
+	poly1271ExchangeDomainName    = ExchangeDomainName
+	poly1271ExchangeDomainVersion = ExchangeDomainVersion
 	poly1271DepositWalletName     = "DepositWallet"
 	poly1271DepositWalletVersion  = "1"
 )
@@ -50,10 +50,25 @@ func signPoly1271Order(signer auth.Signer, order *clobtypes.Order) (string, erro
 		return "", fmt.Errorf("POLY_1271 signing requires a signer that can sign raw digests")
 	}
 
-	side, err := poly1271Side(order.Side)
+	digest, domainSeparator, contentsHash, err := poly1271OrderDigest(order, signer.ChainID())
+	if err != nil {
+		return "", err
+	}
+	innerSignature, err := digestSigner.SignDigest(digest)
 	if err != nil {
 		return "", err
 	}
+	return wrapPoly1271Signature(innerSignature, domainSeparator, contentsHash), nil
+}
+
+// poly1271OrderDigest returns the ERC-7739 TypedDataSign digest signed by the
+// deposit wallet owner, along with the exchange domain separator and order
+// struct hash that are appended to the wrapped signature.
+func poly1271OrderDigest(order *clobtypes.Order, chainID *big.Int) (digest, domainSeparator, contentsHash []byte, err error) {
+	side, err := poly1271Side(order.Side)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	sigType := int(auth.SignaturePoly1271)
 	if order.SignatureType != nil {
 		sigType = *order.SignatureType
@@ -73,30 +88,26 @@ func signPoly1271Order(signer auth.Signer, order *clobtypes.Order) (string, erro
 		Builder:       padBytes32(order.Builder),
 	}
 
-	domainSeparator := poly1271ExchangeDomainSeparator(common.HexToAddress(poly1271ExchangeV2Address), signer.ChainID().Int64())
-	contentsHash, err := poly1271OrderStructHash(orderForHash)
+	domainSeparator = poly1271ExchangeDomainSeparator(common.HexToAddress(poly1271ExchangeV2Address), chainID.Int64())
+	contentsHash, err = poly1271OrderStructHash(orderForHash)
 	if err != nil {
-		return "", err
+		return nil, nil, nil, err
 	}
 	walletSalt, err := poly1271ABIHexBytes32(poly1271Bytes32Zero)
 	if err != nil {
-		return "", err
+		return nil, nil, nil, err
 	}
 	typedDataSignHash := crypto.Keccak256(
 		poly1271ABIBytes32(poly1271TypedDataSignType),
 		contentsHash,
 		poly1271ABIBytes32(poly1271DepositWalletName),
 		poly1271ABIBytes32(poly1271DepositWalletVersion),
-		poly1271ABIUint(signer.ChainID()),
+		poly1271ABIUint(chainID),
 		poly1271ABIAddress(order.Signer),
 		walletSalt,
 	)
-	digest := crypto.Keccak256(append(append([]byte{0x19, 0x01}, domainSeparator...), typedDataSignHash...))
-	innerSignature, err := digestSigner.SignDigest(digest)
-	if err != nil {
-		return "", err
-	}
-	return wrapPoly1271Signature(innerSignature, domainSeparator, contentsHash), nil
+	digest = crypto.Keccak256(append(append([]byte{0x19, 0x01}, domainSeparator...), typedDataSignHash...))
+	return digest, domainSeparator, contentsHash, nil
 }
 
 func poly1271ExchangeDomainSeparator(exchange common.Address, chainID int64) []byte {
@@ -179,3 +190,17 @@ func poly1271Side(side string) (int, error) {
 		return 0, fmt.Errorf("order side must be BUY or SELL, got %q", side)
 	}
 }
+
+// unwrapPoly1271Signature splits a signature produced by wrapPoly1271Signature
+// into the owner's signature, the exchange domain separator, the order struct
+// hash and the contents type string.
+func unwrapPoly1271Signature(wrapped []byte) (innerSignature, domainSeparator, contentsHash []byte, contentsType string, err error) {
+	if len(wrapped) < 65+32+32+2 {
+		return nil, nil, nil, "", fmt.Errorf("POLY_1271 signature too short: %d bytes", len(wrapped))
+	}
+	typeLen := int(binary.BigEndian.Uint16(wrapped[len(wrapped)-2:]))
+	if len(wrapped) != 65+32+32+typeLen+2 {
+		return nil, nil, nil, "", fmt.Errorf("POLY_1271 signature length %d does not match contents type length %d", len(wrapped), typeLen)
+	}
+	return wrapped[:65], wrapped[65:97], wrapped[97:129], string(wrapped[129 : 129+typeLen]), nil
+}
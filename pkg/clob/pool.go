@@ -0,0 +1,328 @@
+package clob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+const defaultPoolConcurrency = 8
+
+// Account describes one trading account of an AccountPool.
+type Account struct {
+	Signer        auth.Signer
+	APIKey        *auth.APIKey
+	SignatureType auth.SignatureType
+	// Funder is the maker address. When nil, Proxy and Safe accounts use the
+	// wallet derived from the signer.
+	Funder *types.Address
+}
+
+// AccountPoolConfig configures an AccountPool.
+type AccountPoolConfig struct {
+	// RequestsPerSecond is the budget shared by every account. Zero keeps the
+	// transport's existing rate limiter, if any.
+	RequestsPerSecond int
+	// HeartbeatInterval starts order heartbeats for every account when set.
+	HeartbeatInterval time.Duration
+	// Concurrency bounds parallel calls in fan-out operations. Defaults to 8.
+	Concurrency int
+	// WSURL is the CLOB WebSocket base URL. Defaults to ws.ProdBaseURL.
+	WSURL string
+	// WSConfig configures the user WebSocket clients.
+	WSConfig ws.ClientConfig
+	// NewWS creates user WebSocket clients. Defaults to ws.NewClientWithConfig
+	// with WSURL and WSConfig.
+	NewWS func(signer auth.Signer, apiKey *auth.APIKey) (ws.Client, error)
+}
+
+// AccountError reports a failed fan-out call for one account.
+type AccountError struct {
+	Account string
+	Err     error
+}
+
+func (e *AccountError) Error() string {
+	return fmt.Sprintf("account %s: %v", e.Account, e.Err)
+}
+
+func (e *AccountError) Unwrap() error {
+	return e.Err
+}
+
+// AccountPool holds authenticated clients for many accounts keyed by name.
+// All clients share one HTTP transport and rate limiter, so the pool as a
+// whole stays within a single request budget. User WebSocket connections are
+// opened on first use.
+type AccountPool struct {
+	base Client
+	cfg  AccountPoolConfig
+
+	mu       sync.RWMutex
+	accounts map[string]*pooledAccount
+}
+
+type pooledAccount struct {
+	Account
+	client Client
+
+	wsMu sync.Mutex
+	ws   ws.Client
+}
+
+// NewAccountPool creates an empty pool on a clone of httpClient. When
+// cfg.RequestsPerSecond is set, the clone gets a new rate limiter shared by
+// every account in the pool.
+func NewAccountPool(httpClient *transport.Client, cfg AccountPoolConfig) *AccountPool {
+	if httpClient == nil {
+		httpClient = transport.NewClient(nil, BaseURL)
+	}
+	httpClient = httpClient.Clone()
+	if cfg.RequestsPerSecond > 0 {
+		httpClient.SetRateLimiter(transport.NewRateLimiter(cfg.RequestsPerSecond))
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = defaultPoolConcurrency
+	}
+	if cfg.WSURL == "" {
+		cfg.WSURL = ws.ProdBaseURL
+	}
+	if cfg.NewWS == nil {
+		url, wsCfg := cfg.WSURL, cfg.WSConfig
+		cfg.NewWS = func(signer auth.Signer, apiKey *auth.APIKey) (ws.Client, error) {
+			return ws.NewClientWithConfig(url, signer, apiKey, wsCfg)
+		}
+	}
+
+	base := NewClient(httpClient)
+	if cfg.HeartbeatInterval > 0 {
+		base = base.WithHeartbeatInterval(cfg.HeartbeatInterval)
+	}
+	return &AccountPool{
+		base:     base,
+		cfg:      cfg,
+		accounts: make(map[string]*pooledAccount),
+	}
+}
+
+// Add registers an account under name.
+func (p *AccountPool) Add(name string, account Account) error {
+	if name == "" {
+		return fmt.Errorf("account name is required")
+	}
+	if account.Signer == nil {
+		return auth.ErrMissingSigner
+	}
+	if account.APIKey == nil {
+		return auth.ErrMissingCreds
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.accounts[name]; ok {
+		return fmt.Errorf("account %q already exists", name)
+	}
+	// WithAuth comes last so the returned client owns its heartbeat loop.
+	client := p.base.WithSignatureType(account.SignatureType)
+	if account.Funder != nil {
+		client = client.WithFunder(*account.Funder)
+	}
+	client = client.WithAuth(account.Signer, account.APIKey)
+	p.accounts[name] = &pooledAccount{Account: account, client: client}
+	return nil
+}
+
+// Remove stops the account's heartbeats, closes its WebSocket connection and
+// drops it from the pool.
+func (p *AccountPool) Remove(name string) error {
+	p.mu.Lock()
+	acct, ok := p.accounts[name]
+	delete(p.accounts, name)
+	p.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("unknown account %q", name)
+	}
+	return acct.close()
+}
+
+// Names returns the account names in sorted order.
+func (p *AccountPool) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	names := make([]string, 0, len(p.accounts))
+	for name := range p.accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Client returns the authenticated client of the named account.
+func (p *AccountPool) Client(name string) (Client, error) {
+	acct, err := p.account(name)
+	if err != nil {
+		return nil, err
+	}
+	return acct.client, nil
+}
+
+// WS returns the named account's user WebSocket client, connecting it on
+// first use.
+func (p *AccountPool) WS(name string) (ws.Client, error) {
+	acct, err := p.account(name)
+	if err != nil {
+		return nil, err
+	}
+	acct.wsMu.Lock()
+	defer acct.wsMu.Unlock()
+	if acct.ws == nil {
+		client, err := p.cfg.NewWS(acct.Signer, acct.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("open websocket for account %s: %w", name, err)
+		}
+		acct.ws = client
+	}
+	return acct.ws, nil
+}
+
+// ForEach calls fn for every account, at most cfg.Concurrency at a time. The
+// returned error joins an *AccountError for each failed account.
+func (p *AccountPool) ForEach(ctx context.Context, fn func(ctx context.Context, name string, client Client) error) error {
+	p.mu.RLock()
+	accounts := make(map[string]Client, len(p.accounts))
+	for name, acct := range p.accounts {
+		accounts[name] = acct.client
+	}
+	p.mu.RUnlock()
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, p.cfg.Concurrency)
+	)
+	for name, client := range accounts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, &AccountError{Account: name, Err: ctx.Err()})
+				mu.Unlock()
+				return
+			}
+			if err := fn(ctx, name, client); err != nil {
+				mu.Lock()
+				errs = append(errs, &AccountError{Account: name, Err: err})
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// CancelAll cancels every open order of every account. Responses are keyed by
+// account name; accounts that failed are reported in the error.
+func (p *AccountPool) CancelAll(ctx context.Context) (map[string]clobtypes.CancelAllResponse, error) {
+	var mu sync.Mutex
+	results := make(map[string]clobtypes.CancelAllResponse)
+	err := p.ForEach(ctx, func(ctx context.Context, name string, client Client) error {
+		resp, err := client.CancelAll(ctx)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		results[name] = resp
+		mu.Unlock()
+		return nil
+	})
+	return results, err
+}
+
+// PoolBalance aggregates a balance across accounts.
+type PoolBalance struct {
+	// Total is the sum of the account balances in base units.
+	Total decimal.Decimal
+	// Accounts holds each account's response.
+	Accounts map[string]clobtypes.BalanceAllowanceResponse
+}
+
+// Balances queries req for every account, using each account's signature
+// type unless req sets one, and sums the balances.
+func (p *AccountPool) Balances(ctx context.Context, req *clobtypes.BalanceAllowanceRequest) (PoolBalance, error) {
+	if req == nil {
+		// BalanceAllowance only fills in the signature type for a non-nil request.
+		req = &clobtypes.BalanceAllowanceRequest{}
+	}
+	var mu sync.Mutex
+	result := PoolBalance{Accounts: make(map[string]clobtypes.BalanceAllowanceResponse)}
+	err := p.ForEach(ctx, func(ctx context.Context, name string, client Client) error {
+		resp, err := client.BalanceAllowance(ctx, req)
+		if err != nil {
+			return err
+		}
+		balance, err := decimal.NewFromString(resp.Balance)
+		if err != nil {
+			return fmt.Errorf("parse balance %q: %w", resp.Balance, err)
+		}
+		mu.Lock()
+		result.Accounts[name] = resp
+		result.Total = result.Total.Add(balance)
+		mu.Unlock()
+		return nil
+	})
+	return result, err
+}
+
+// Close stops every account's heartbeats and closes open WebSocket clients.
+func (p *AccountPool) Close() error {
+	p.mu.Lock()
+	accounts := p.accounts
+	p.accounts = make(map[string]*pooledAccount)
+	p.mu.Unlock()
+
+	var errs []error
+	for _, acct := range accounts {
+		if err := acct.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *AccountPool) account(name string) (*pooledAccount, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	acct, ok := p.accounts[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %q", name)
+	}
+	return acct, nil
+}
+
+func (a *pooledAccount) close() error {
+	a.client.StopHeartbeats()
+	a.wsMu.Lock()
+	defer a.wsMu.Unlock()
+	if a.ws == nil {
+		return nil
+	}
+	err := a.ws.Close()
+	a.ws = nil
+	return err
+}
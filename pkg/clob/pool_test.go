@@ -0,0 +1,131 @@
+package clob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+type closeRecordingWS struct {
+	ws.Client
+	closed atomic.Bool
+}
+
+func (c *closeRecordingWS) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+func TestAccountPoolFanOut(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		sigTypes = make(map[string]string)
+	)
+	balances := map[string]string{"key-a": "1500000", "key-b": "2500000"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("DELETE /cancel-all", func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(auth.HeaderPolyAPIKey)
+		if key == "key-c" {
+			http.Error(w, `{"error":"Unauthorized/Invalid api key"}`, http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(clobtypes.CancelAllResponse{Canceled: []string{"order-" + key}})
+	})
+	mux.HandleFunc("GET /balance-allowance", func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(auth.HeaderPolyAPIKey)
+		mu.Lock()
+		sigTypes[key] = r.URL.Query().Get("signature_type")
+		mu.Unlock()
+		json.NewEncoder(w).Encode(clobtypes.BalanceAllowanceResponse{Balance: balances[key]})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	var opened atomic.Int32
+	userWS := &closeRecordingWS{}
+	pool := NewAccountPool(transport.NewClient(srv.Client(), srv.URL), AccountPoolConfig{
+		RequestsPerSecond: 2,
+		NewWS: func(auth.Signer, *auth.APIKey) (ws.Client, error) {
+			opened.Add(1)
+			return userWS, nil
+		},
+	})
+	signer := mustSigner(t)
+	if err := pool.Add("a", Account{Signer: signer, APIKey: &auth.APIKey{Key: "key-a"}, SignatureType: auth.SignatureProxy}); err != nil {
+		t.Fatalf("Add a: %v", err)
+	}
+	if err := pool.Add("b", Account{Signer: signer, APIKey: &auth.APIKey{Key: "key-b"}, SignatureType: auth.SignatureGnosisSafe}); err != nil {
+		t.Fatalf("Add b: %v", err)
+	}
+	if err := pool.Add("a", Account{Signer: signer, APIKey: &auth.APIKey{Key: "key-a"}}); err == nil {
+		t.Fatal("expected duplicate account error")
+	}
+
+	ctx := context.Background()
+	start := time.Now()
+	balance, err := pool.Balances(ctx, &clobtypes.BalanceAllowanceRequest{AssetType: clobtypes.AssetTypeCollateral})
+	if err != nil {
+		t.Fatalf("Balances: %v", err)
+	}
+	if balance.Total.String() != "4000000" || len(balance.Accounts) != 2 {
+		t.Fatalf("unexpected balances %+v", balance)
+	}
+	if sigTypes["key-a"] != "1" || sigTypes["key-b"] != "2" {
+		t.Fatalf("signature types not applied per account: %v", sigTypes)
+	}
+	mu.Lock()
+	sigTypes = make(map[string]string)
+	mu.Unlock()
+	if _, err := pool.Balances(ctx, nil); err != nil {
+		t.Fatalf("Balances(nil): %v", err)
+	}
+	if sigTypes["key-a"] != "1" || sigTypes["key-b"] != "2" {
+		t.Fatalf("signature types not applied per account for a nil request: %v", sigTypes)
+	}
+
+	if err := pool.Add("c", Account{Signer: signer, APIKey: &auth.APIKey{Key: "key-c"}}); err != nil {
+		t.Fatalf("Add c: %v", err)
+	}
+	results, err := pool.CancelAll(ctx)
+	var accountErr *AccountError
+	if !errors.As(err, &accountErr) || accountErr.Account != "c" || !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized error for account c, got %v", err)
+	}
+	if len(results) != 2 || results["a"].Canceled[0] != "order-key-a" {
+		t.Fatalf("unexpected cancel results %+v", results)
+	}
+	// Seven requests against a shared budget of two per second.
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("rate limit not shared across accounts, took %v", elapsed)
+	}
+
+	if opened.Load() != 0 {
+		t.Fatal("websocket opened before first use")
+	}
+	first, _ := pool.WS("a")
+	second, _ := pool.WS("a")
+	if first != second || opened.Load() != 1 {
+		t.Fatalf("expected one lazily opened websocket, got %d", opened.Load())
+	}
+	if _, err := pool.WS("missing"); err == nil {
+		t.Fatal("expected unknown account error")
+	}
+	if err := pool.Close(); err != nil || !userWS.closed.Load() {
+		t.Fatalf("Close: %v closed=%v", err, userWS.closed.Load())
+	}
+	if len(pool.Names()) != 0 {
+		t.Fatal("expected empty pool after Close")
+	}
+}
@@ -0,0 +1,147 @@
+package clob
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+// ReadonlyClient is the query-only subset of Client. It has no methods that
+// place, cancel or sign orders, manage API keys or change account state, so
+// code holding a ReadonlyClient cannot trade.
+type ReadonlyClient interface {
+	// -- System Status --
+
+	Health(ctx context.Context) (string, error)
+	Time(ctx context.Context) (clobtypes.TimeResponse, error)
+	Geoblock(ctx context.Context) (clobtypes.GeoblockResponse, error)
+
+	// -- Market Data --
+
+	Markets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	MarketsAll(ctx context.Context, req *clobtypes.MarketsRequest) ([]clobtypes.Market, error)
+	MarketsKeyset(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	Market(ctx context.Context, id string) (clobtypes.MarketResponse, error)
+	SimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	SamplingMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	SamplingSimplifiedMarkets(ctx context.Context, req *clobtypes.MarketsRequest) (clobtypes.MarketsResponse, error)
+	MarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	SimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	SamplingMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	SamplingSimplifiedMarketsIter(ctx context.Context, req *clobtypes.MarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Market, error]
+	MarketTradesEvents(ctx context.Context, id string) (clobtypes.MarketTradesEventsResponse, error)
+	ClobMarketInfo(ctx context.Context, conditionID string) (*clobtypes.ClobMarketDetails, error)
+	MarketByToken(ctx context.Context, tokenID string) (clobtypes.MarketByTokenResponse, error)
+	MarketsLiveActivity(ctx context.Context, req *clobtypes.LiveActivityRequest) (clobtypes.LiveActivityResponse, error)
+	MarketLiveActivity(ctx context.Context, conditionID string) (clobtypes.LiveActivityResponse, error)
+
+	// -- Order Book & Pricing --
+
+	OrderBook(ctx context.Context, req *clobtypes.BookRequest) (clobtypes.OrderBookResponse, error)
+	OrderBooks(ctx context.Context, req *clobtypes.BooksRequest) (clobtypes.OrderBooksResponse, error)
+	OrderBooksQuery(ctx context.Context, req *clobtypes.BooksQueryRequest) (clobtypes.OrderBooksResponse, error)
+	Midpoint(ctx context.Context, req *clobtypes.MidpointRequest) (clobtypes.MidpointResponse, error)
+	Midpoints(ctx context.Context, req *clobtypes.MidpointsRequest) (clobtypes.MidpointsResponse, error)
+	Price(ctx context.Context, req *clobtypes.PriceRequest) (clobtypes.PriceResponse, error)
+	Prices(ctx context.Context, req *clobtypes.PricesRequest) (clobtypes.PricesResponse, error)
+	AllPrices(ctx context.Context) (clobtypes.PricesResponse, error)
+	Spread(ctx context.Context, req *clobtypes.SpreadRequest) (clobtypes.SpreadResponse, error)
+	Spreads(ctx context.Context, req *clobtypes.SpreadsRequest) (clobtypes.SpreadsResponse, error)
+	LastTradePrice(ctx context.Context, req *clobtypes.LastTradePriceRequest) (clobtypes.LastTradePriceResponse, error)
+	LastTradesPrices(ctx context.Context, req *clobtypes.LastTradesPricesRequest) (clobtypes.LastTradesPricesResponse, error)
+	LastTradesPricesQuery(ctx context.Context, req *clobtypes.LastTradesPricesQueryRequest) (clobtypes.LastTradesPricesResponse, error)
+	TickSize(ctx context.Context, req *clobtypes.TickSizeRequest) (clobtypes.TickSizeResponse, error)
+	TickSizeByPath(ctx context.Context, tokenID string) (clobtypes.TickSizeResponse, error)
+	NegRisk(ctx context.Context, req *clobtypes.NegRiskRequest) (clobtypes.NegRiskResponse, error)
+	NegRiskByPath(ctx context.Context, tokenID string) (clobtypes.NegRiskResponse, error)
+	FeeRate(ctx context.Context, req *clobtypes.FeeRateRequest) (clobtypes.FeeRateResponse, error)
+	FeeRateByPath(ctx context.Context, tokenID string) (clobtypes.FeeRateResponse, error)
+	PricesHistory(ctx context.Context, req *clobtypes.PricesHistoryRequest) (clobtypes.PricesHistoryResponse, error)
+	BatchPricesHistory(ctx context.Context, req *clobtypes.BatchPricesHistoryRequest) (clobtypes.BatchPricesHistoryResponse, error)
+
+	// -- Orders & Trades --
+
+	Order(ctx context.Context, id string) (clobtypes.OrderResponse, error)
+	Orders(ctx context.Context, req *clobtypes.OrdersRequest) (clobtypes.OrdersResponse, error)
+	OrdersAll(ctx context.Context, req *clobtypes.OrdersRequest) ([]clobtypes.OrderResponse, error)
+	OrdersIter(ctx context.Context, req *clobtypes.OrdersRequest, opts ...paginate.Option) iter.Seq2[clobtypes.OrderResponse, error]
+	Trades(ctx context.Context, req *clobtypes.TradesRequest) (clobtypes.TradesResponse, error)
+	TradesAll(ctx context.Context, req *clobtypes.TradesRequest) ([]clobtypes.Trade, error)
+	TradesIter(ctx context.Context, req *clobtypes.TradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error]
+	BuilderTrades(ctx context.Context, req *clobtypes.BuilderTradesRequest) (clobtypes.BuilderTradesResponse, error)
+	BuilderTradesAll(ctx context.Context, req *clobtypes.BuilderTradesRequest) ([]clobtypes.Trade, error)
+	BuilderTradesIter(ctx context.Context, req *clobtypes.BuilderTradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error]
+	OrderScoring(ctx context.Context, req *clobtypes.OrderScoringRequest) (clobtypes.OrderScoringResponse, error)
+	OrdersScoring(ctx context.Context, req *clobtypes.OrdersScoringRequest) (clobtypes.OrdersScoringResponse, error)
+
+	// -- Account --
+
+	BalanceAllowance(ctx context.Context, req *clobtypes.BalanceAllowanceRequest) (clobtypes.BalanceAllowanceResponse, error)
+	Notifications(ctx context.Context, req *clobtypes.NotificationsRequest) (clobtypes.NotificationsResponse, error)
+	ClosedOnlyStatus(ctx context.Context) (clobtypes.ClosedOnlyResponse, error)
+
+	// -- Rewards & Earnings --
+
+	UserEarnings(ctx context.Context, req *clobtypes.UserEarningsRequest) (clobtypes.UserEarningsResponse, error)
+	UserTotalEarnings(ctx context.Context, req *clobtypes.UserTotalEarningsRequest) (clobtypes.UserTotalEarningsResponse, error)
+	UserRewardPercentages(ctx context.Context, req *clobtypes.UserRewardPercentagesRequest) (clobtypes.UserRewardPercentagesResponse, error)
+	RewardsMarketsCurrent(ctx context.Context, req *clobtypes.RewardsMarketsRequest) (clobtypes.RewardsMarketsResponse, error)
+	RewardsMarkets(ctx context.Context, req *clobtypes.RewardsMarketRequest) (clobtypes.RewardsMarketResponse, error)
+	UserRewardsByMarket(ctx context.Context, req *clobtypes.UserRewardsByMarketRequest) (clobtypes.UserRewardsByMarketResponse, error)
+	UserEarningsIter(ctx context.Context, req *clobtypes.UserEarningsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.UserEarning, error]
+	RewardsMarketsCurrentIter(ctx context.Context, req *clobtypes.RewardsMarketsRequest, opts ...paginate.Option) iter.Seq2[clobtypes.CurrentReward, error]
+	RewardsMarketsIter(ctx context.Context, req *clobtypes.RewardsMarketRequest, opts ...paginate.Option) iter.Seq2[clobtypes.MarketReward, error]
+}
+
+// Every Client is a ReadonlyClient; this keeps the two method sets in sync.
+var _ ReadonlyClient = Client(nil)
+
+// readonlyClient hides the write methods of the wrapped Client. Embedding the
+// interface promotes only ReadonlyClient's methods, so a type assertion back
+// to Client fails.
+type readonlyClient struct {
+	ReadonlyClient
+}
+
+// NewReadonlyClient returns a query-only view of client. The view cannot be
+// converted back into a Client.
+func NewReadonlyClient(client Client) ReadonlyClient {
+	return readonlyClient{ReadonlyClient: client}
+}
+
+// NewReadonlyClientWithKey authenticates client with a readonly API key
+// belonging to address and returns a query-only view of it. No private key is
+// involved: requests carry L2 headers from a watch-only signer. The key is
+// first checked against the exchange, so credentials that could trade are
+// rejected. Heartbeats are disabled on the returned client.
+func NewReadonlyClientWithKey(ctx context.Context, client Client, address common.Address, apiKey *auth.APIKey) (ReadonlyClient, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+	if address == (common.Address{}) {
+		return nil, fmt.Errorf("address is required")
+	}
+	if apiKey == nil || apiKey.Key == "" {
+		return nil, auth.ErrMissingCreds
+	}
+	resp, err := client.ValidateReadonlyAPIKey(ctx, &clobtypes.ValidateReadonlyAPIKeyRequest{
+		Address: address.Hex(),
+		APIKey:  apiKey.Key,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("validate readonly api key: %w", err)
+	}
+	if !resp.Valid {
+		return nil, fmt.Errorf("%w: %s is not a readonly api key for %s", sdkerrors.ErrUnauthorized, apiKey.Key, address.Hex())
+	}
+	// L2 headers only use the signer's address, so the chain does not matter.
+	signer := auth.NewWatchOnlySigner(address, auth.PolygonChainID)
+	return NewReadonlyClient(client.WithHeartbeatInterval(0).WithAuth(signer, apiKey)), nil
+}
@@ -0,0 +1,56 @@
+package clob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+func TestReadonlyClientWithKey(t *testing.T) {
+	address := mustSigner(t).Address()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /auth/validate-readonly-api-key", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		valid := q.Get("key") == "ro-key" && q.Get("address") == address.Hex()
+		json.NewEncoder(w).Encode(clobtypes.ValidateReadonlyAPIKeyResponse{Valid: valid})
+	})
+	mux.HandleFunc("GET /data/order/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(auth.HeaderPolyAPIKey) != "ro-key" || r.Header.Get(auth.HeaderPolyAddress) != address.Hex() {
+			http.Error(w, `{"error":"Unauthorized/Invalid api key"}`, http.StatusUnauthorized)
+			return
+		}
+		json.NewEncoder(w).Encode(clobtypes.OrderResponse{ID: r.PathValue("id")})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	client := NewClient(transport.NewClient(srv.Client(), srv.URL))
+	ro, err := NewReadonlyClientWithKey(ctx, client, address, &auth.APIKey{Key: "ro-key", Secret: "c2VjcmV0", Passphrase: "p"})
+	if err != nil {
+		t.Fatalf("NewReadonlyClientWithKey: %v", err)
+	}
+	if _, ok := ro.(Client); ok {
+		t.Fatal("readonly client must not be convertible to Client")
+	}
+	order, err := ro.Order(ctx, "0xabc")
+	if err != nil || order.ID != "0xabc" {
+		t.Fatalf("Order: %+v %v", order, err)
+	}
+
+	_, err = NewReadonlyClientWithKey(ctx, client, address, &auth.APIKey{Key: "trading-key", Secret: "c2VjcmV0", Passphrase: "p"})
+	if !errors.Is(err, sdkerrors.ErrUnauthorized) {
+		t.Fatalf("expected unauthorized for non-readonly key, got %v", err)
+	}
+	if _, err := NewReadonlyClientWithKey(ctx, client, address, nil); !errors.Is(err, auth.ErrMissingCreds) {
+		t.Fatalf("expected missing creds, got %v", err)
+	}
+}
@@ -2,10 +2,12 @@ package rfq
 
 import (
 	"context"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
 )
 
@@ -13,9 +15,11 @@ type Client interface {
 	CreateRFQRequest(ctx context.Context, req *RFQRequest) (RFQRequestResponse, error)
 	CancelRFQRequest(ctx context.Context, req *RFQCancelRequest) (RFQCancelResponse, error)
 	RFQRequests(ctx context.Context, req *RFQRequestsQuery) (RFQRequestsResponse, error)
+	RFQRequestsIter(ctx context.Context, req *RFQRequestsQuery, opts ...paginate.Option) iter.Seq2[RFQRequestItem, error]
 	CreateRFQQuote(ctx context.Context, req *RFQQuote) (RFQQuoteResponse, error)
 	CancelRFQQuote(ctx context.Context, req *RFQCancelQuote) (RFQCancelResponse, error)
 	RFQQuotes(ctx context.Context, req *RFQQuotesQuery) (RFQQuotesResponse, error)
+	RFQQuotesIter(ctx context.Context, req *RFQQuotesQuery, opts ...paginate.Option) iter.Seq2[RFQQuoteItem, error]
 	RFQBestQuote(ctx context.Context, req *RFQBestQuoteQuery) (RFQBestQuoteResponse, error)
 	RFQRequestAccept(ctx context.Context, req *RFQAcceptRequest) (RFQAcceptResponse, error)
 	RFQQuoteApprove(ctx context.Context, req *RFQApproveQuote) (RFQApproveResponse, error)
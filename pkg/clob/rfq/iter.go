@@ -0,0 +1,64 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+const defaultPageSize = 100
+
+// offsetIter walks an RFQ data endpoint. The query's limit and offset (or
+// cursor, which the API treats as an offset) seed the walk unless overridden by opts.
+func offsetIter[T any](ctx context.Context, limit int, offset, cursor string, opts []paginate.Option, fetch paginate.OffsetFetch[T]) iter.Seq2[T, error] {
+	o := paginate.Apply(opts...)
+	def := defaultPageSize
+	if limit > 0 {
+		def = limit
+	}
+	if offset == "" {
+		offset = cursor
+	}
+	if o.Offset == 0 && offset != "" {
+		start, err := strconv.Atoi(offset)
+		if err != nil {
+			return func(yield func(T, error) bool) {
+				var zero T
+				yield(zero, fmt.Errorf("rfq: offset %q is not numeric", offset))
+			}
+		}
+		o.Offset = start
+	}
+	return paginate.Offset(ctx, o.Size(def, 0), o, fetch)
+}
+
+func (c *clientImpl) RFQRequestsIter(ctx context.Context, req *RFQRequestsQuery, opts ...paginate.Option) iter.Seq2[RFQRequestItem, error] {
+	base := RFQRequestsQuery{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, base.Cursor, opts, func(ctx context.Context, offset, limit int) ([]RFQRequestItem, error) {
+		nextReq := base
+		nextReq.Cursor = ""
+		nextReq.Offset = strconv.Itoa(offset)
+		nextReq.Limit = limit
+		return c.RFQRequests(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) RFQQuotesIter(ctx context.Context, req *RFQQuotesQuery, opts ...paginate.Option) iter.Seq2[RFQQuoteItem, error] {
+	base := RFQQuotesQuery{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, base.Cursor, opts, func(ctx context.Context, offset, limit int) ([]RFQQuoteItem, error) {
+		nextReq := base
+		nextReq.Cursor = ""
+		nextReq.Offset = strconv.Itoa(offset)
+		nextReq.Limit = limit
+		return c.RFQQuotes(ctx, &nextReq)
+	})
+}
@@ -0,0 +1,95 @@
+package rfq
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+// queryDoer answers by path and query and records every request.
+type queryDoer struct {
+	responses map[string]string
+	requests  []string
+}
+
+func (d *queryDoer) Do(req *http.Request) (*http.Response, error) {
+	key := req.URL.Path + "?" + req.URL.RawQuery
+	d.requests = append(d.requests, key)
+	payload, ok := d.responses[key]
+	if !ok {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"error":"unexpected request"}`)),
+			Header:     make(http.Header),
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewBufferString(payload)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestRFQRequestsIterPagesByOffset(t *testing.T) {
+	doer := &queryDoer{
+		responses: map[string]string{
+			"/rfq/data/requests?limit=2&offset=4&state=active": `[{"id":"a"},{"id":"b"}]`,
+			"/rfq/data/requests?limit=2&offset=6&state=active": `[{"id":"c"}]`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	// The query's cursor is an offset and seeds the walk.
+	var ids []string
+	for item, err := range client.RFQRequestsIter(context.Background(), &RFQRequestsQuery{Limit: 2, Cursor: "4", State: RFQStateActive}) {
+		if err != nil {
+			t.Fatalf("RFQRequestsIter failed: %v", err)
+		}
+		ids = append(ids, item.ID)
+	}
+	if fmt.Sprint(ids) != "[a b c]" {
+		t.Fatalf("unexpected items %v (requests %v)", ids, doer.requests)
+	}
+}
+
+func TestRFQQuotesIterStops(t *testing.T) {
+	doer := &queryDoer{
+		responses: map[string]string{
+			"/rfq/data/quotes?limit=1&offset=0": `[{"id":"q1"}]`,
+			"/rfq/data/quotes?limit=1&offset=1": `[{"id":"q2"}]`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	ctx := context.Background()
+
+	var ids []string
+	for item, err := range client.RFQQuotesIter(ctx, nil, paginate.WithPageSize(1), paginate.WithMaxPages(2)) {
+		if err != nil {
+			t.Fatalf("RFQQuotesIter failed: %v", err)
+		}
+		ids = append(ids, item.ID)
+	}
+	if fmt.Sprint(ids) != "[q1 q2]" || len(doer.requests) != 2 {
+		t.Fatalf("unexpected items %v (requests %v)", ids, doer.requests)
+	}
+
+	doer.requests = nil
+	for range client.RFQQuotesIter(ctx, nil, paginate.WithPageSize(1)) {
+		break
+	}
+	if len(doer.requests) != 1 {
+		t.Fatalf("breaking should stop fetching, got requests %v", doer.requests)
+	}
+
+	for _, err := range client.RFQQuotesIter(ctx, &RFQQuotesQuery{Offset: "next"}) {
+		if err == nil {
+			t.Fatal("expected error for non-numeric offset")
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package clob
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+)
+
+// maxOrderSalt is the largest salt the SDK generates; larger values lose
+// precision in JSON clients that decode numbers as float64.
+const maxOrderSalt = 1<<53 - 1
+
+// OrderVerification is the report produced by VerifySignedOrder.
+type OrderVerification struct {
+	SignatureType int `json:"signatureType"`
+	// Digest is the hash the key signed.
+	Digest hexutil.Bytes `json:"digest,omitempty"`
+	// RecoveredSigner is the key recovered from the signature. For POLY_1271
+	// orders it is the deposit wallet owner; the signature alone does not
+	// prove it controls the wallet, so it is checked against WithExpectedOwner.
+	RecoveredSigner common.Address `json:"recoveredSigner"`
+	// ExpectedMaker is the proxy or Safe wallet derived from the recovered
+	// signer. It is zero for EOA and POLY_1271 orders.
+	ExpectedMaker common.Address `json:"expectedMaker"`
+	// Price is the price implied by the maker and taker amounts.
+	Price decimal.Decimal `json:"price"`
+	// Issues lists every failed check. The order is valid when it is empty.
+	Issues []string `json:"issues,omitempty"`
+}
+
+// Valid reports whether every check passed.
+func (v *OrderVerification) Valid() bool {
+	return len(v.Issues) == 0
+}
+
+func (v *OrderVerification) addIssue(format string, args ...interface{}) {
+	v.Issues = append(v.Issues, fmt.Sprintf(format, args...))
+}
+
+// VerifyOption configures VerifySignedOrder.
+type VerifyOption func(*verifyConfig)
+
+type verifyConfig struct {
+	owner common.Address
+}
+
+// WithExpectedOwner requires the signature to recover to owner. POLY_1271
+// orders need it: the deposit wallet's owner cannot be derived from the order,
+// so without it the report carries an "owner unverified" issue.
+func WithExpectedOwner(owner common.Address) VerifyOption {
+	return func(c *verifyConfig) { c.owner = owner }
+}
+
+// VerifySignedOrder checks a signed order without contacting the API:
+//   - EOA: the signature recovers to the order signer, which is the maker.
+//   - Proxy and Safe: the signature recovers to the order signer, and the
+//     maker is the proxy or Safe wallet derived from it.
+//   - POLY_1271: the wrapped signature carries the order's domain separator
+//     and struct hash, and its inner signature recovers to the expected owner.
+//
+// Salt, token, side and amounts are also checked. The report is always
+// returned; the error wraps ErrInvalidSignature when any check failed.
+func VerifySignedOrder(signed *clobtypes.SignedOrder, chainID int64, opts ...VerifyOption) (*OrderVerification, error) {
+	if signed == nil {
+		return nil, fmt.Errorf("signed order is required")
+	}
+	var cfg verifyConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	order := &signed.Order
+	report := &OrderVerification{SignatureType: orderSignatureType(order)}
+
+	checkOrderFields(order, report)
+
+	sig, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signed.Signature), "0x"))
+	if err != nil {
+		report.addIssue("signature is not valid hex")
+	} else {
+		switch report.SignatureType {
+		case int(auth.SignatureEOA), int(auth.SignatureProxy), int(auth.SignatureGnosisSafe):
+			verifyOrderSignature(order, sig, chainID, report)
+		case int(auth.SignaturePoly1271):
+			verifyPoly1271OrderSignature(order, sig, chainID, report)
+		default:
+			report.addIssue("unknown signature type %d", report.SignatureType)
+		}
+		checkOwner(cfg.owner, report)
+	}
+
+	if report.Valid() {
+		return report, nil
+	}
+	return report, fmt.Errorf("%w: %s", sdkerrors.ErrInvalidSignature, strings.Join(report.Issues, "; "))
+}
+
+// checkOwner compares the recovered key with the expected owner.
+func checkOwner(owner common.Address, report *OrderVerification) {
+	if report.RecoveredSigner == (common.Address{}) {
+		return
+	}
+	switch {
+	case owner != (common.Address{}):
+		if report.RecoveredSigner != owner {
+			report.addIssue("signature recovers to %s, expected owner is %s", report.RecoveredSigner.Hex(), owner.Hex())
+		}
+	case report.SignatureType == int(auth.SignaturePoly1271):
+		report.addIssue("POLY_1271 owner %s unverified: no expected owner supplied", report.RecoveredSigner.Hex())
+	}
+}
+
+func checkOrderFields(order *clobtypes.Order, report *OrderVerification) {
+	if order.Salt.Int == nil || order.Salt.Int.Sign() <= 0 {
+		report.addIssue("salt must be positive")
+	} else if order.Salt.Int.Cmp(big.NewInt(maxOrderSalt)) > 0 {
+		report.addIssue("salt %s exceeds 2^53-1", order.Salt.Int)
+	}
+	if order.TokenID.Int == nil || order.TokenID.Int.Sign() == 0 {
+		report.addIssue("token_id must be non-zero")
+	}
+	if order.Maker == (common.Address{}) {
+		report.addIssue("maker is zero")
+	}
+	if order.Signer == (common.Address{}) {
+		report.addIssue("signer is zero")
+	}
+
+	side := strings.ToUpper(strings.TrimSpace(order.Side))
+	if side != "BUY" && side != "SELL" {
+		report.addIssue("side must be BUY or SELL, got %q", order.Side)
+	}
+	makerOK := checkBaseUnits("maker_amount", order.MakerAmount, report)
+	takerOK := checkBaseUnits("taker_amount", order.TakerAmount, report)
+	if !makerOK || !takerOK || (side != "BUY" && side != "SELL") {
+		return
+	}
+
+	// BUY pays USDC for shares, SELL receives USDC for shares.
+	if side == "BUY" {
+		report.Price = order.MakerAmount.Div(order.TakerAmount)
+	} else {
+		report.Price = order.TakerAmount.Div(order.MakerAmount)
+	}
+	if !report.Price.IsPositive() || report.Price.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		report.addIssue("implied price %s is outside (0, 1)", report.Price)
+	}
+}
+
+// checkBaseUnits reports whether amount is a positive integer number of base
+// units.
+func checkBaseUnits(name string, amount decimal.Decimal, report *OrderVerification) bool {
+	if !amount.IsPositive() {
+		report.addIssue("%s must be positive", name)
+		return false
+	}
+	if !amount.IsInteger() {
+		report.addIssue("%s %s is not a whole number of base units", name, amount)
+		return false
+	}
+	return true
+}
+
+func verifyOrderSignature(order *clobtypes.Order, sig []byte, chainID int64, report *OrderVerification) {
+	if len(sig) != 65 {
+		report.addIssue("signature must be 65 bytes, got %d", len(sig))
+		return
+	}
+	td, err := orderTypedData(order, big.NewInt(chainID))
+	if err != nil {
+		report.addIssue("build typed data: %v", err)
+		return
+	}
+	digest, _, err := apitypes.TypedDataAndHash(td)
+	if err != nil {
+		report.addIssue("hash typed data: %v", err)
+		return
+	}
+	report.Digest = digest
+	recovered, err := recoverAddress(digest, sig)
+	if err != nil {
+		report.addIssue("recover signer: %v", err)
+		return
+	}
+	report.RecoveredSigner = recovered
+	if recovered != order.Signer {
+		report.addIssue("signature recovers to %s, order signer is %s", recovered.Hex(), order.Signer.Hex())
+	}
+
+	switch report.SignatureType {
+	case int(auth.SignatureEOA):
+		if order.Maker != recovered {
+			report.addIssue("EOA order maker %s is not the signer %s", order.Maker.Hex(), recovered.Hex())
+		}
+	case int(auth.SignatureProxy):
+		report.ExpectedMaker, err = auth.DeriveProxyWalletForChain(recovered, chainID)
+	case int(auth.SignatureGnosisSafe):
+		report.ExpectedMaker, err = auth.DeriveSafeWalletForChain(recovered, chainID)
+	}
+	if err != nil {
+		report.addIssue("derive maker: %v", err)
+		return
+	}
+	if report.ExpectedMaker != (common.Address{}) && order.Maker != report.ExpectedMaker {
+		report.addIssue("maker %s is not the wallet %s derived from signer %s", order.Maker.Hex(), report.ExpectedMaker.Hex(), recovered.Hex())
+	}
+}
+
+func verifyPoly1271OrderSignature(order *clobtypes.Order, sig []byte, chainID int64, report *OrderVerification) {
+	inner, domainSeparator, contentsHash, contentsType, err := unwrapPoly1271Signature(sig)
+	if err != nil {
+		report.addIssue("%v", err)
+		return
+	}
+	if order.Maker != order.Signer {
+		report.addIssue("POLY_1271 order maker %s is not the deposit wallet signer %s", order.Maker.Hex(), order.Signer.Hex())
+	}
+	if order.Timestamp <= 0 {
+		report.addIssue("POLY_1271 order timestamp must be set")
+	}
+	digest, wantDomain, wantContents, err := poly1271OrderDigest(order, big.NewInt(chainID))
+	if err != nil {
+		report.addIssue("hash POLY_1271 order: %v", err)
+		return
+	}
+	report.Digest = digest
+	if contentsType != poly1271OrderType {
+		report.addIssue("wrapped contents type does not match the Order type")
+	}
+	if !bytes.Equal(domainSeparator, wantDomain) {
+		report.addIssue("wrapped domain separator does not match the exchange")
+	}
+	if !bytes.Equal(contentsHash, wantContents) {
+		report.addIssue("wrapped contents hash does not match the order")
+	}
+	recovered, err := recoverAddress(digest, inner)
+	if err != nil {
+		report.addIssue("recover owner: %v", err)
+		return
+	}
+	report.RecoveredSigner = recovered
+}
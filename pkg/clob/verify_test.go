@@ -0,0 +1,118 @@
+package clob
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+func verifyTestOrder() *clobtypes.Order {
+	return &clobtypes.Order{
+		Salt:        types.U256{Int: big.NewInt(42)},
+		TokenID:     types.U256{Int: big.NewInt(123)},
+		MakerAmount: decimal.NewFromInt(5_000_000),
+		TakerAmount: decimal.NewFromInt(10_000_000),
+		Side:        "BUY",
+	}
+}
+
+func TestVerifySignedOrderSignatureTypes(t *testing.T) {
+	key := mustSigner(t)
+	for _, sigType := range []auth.SignatureType{auth.SignatureEOA, auth.SignatureProxy, auth.SignatureGnosisSafe, auth.SignaturePoly1271} {
+		order := verifyTestOrder()
+		if sigType == auth.SignaturePoly1271 {
+			order.Maker = common.HexToAddress("0x9c90cad21cb08320Fb224EAb032dDAE311c017Ef")
+		}
+		signed, err := signOrderWithCreds(key, &auth.APIKey{Key: "api-key"}, order, &sigType, nil, nil)
+		if err != nil {
+			t.Fatalf("sign type %d: %v", sigType, err)
+		}
+		report, err := VerifySignedOrder(signed, 137, WithExpectedOwner(key.Address()))
+		if err != nil {
+			t.Fatalf("verify type %d: %v", sigType, err)
+		}
+		if report.RecoveredSigner != key.Address() {
+			t.Fatalf("type %d recovered %s, want %s", sigType, report.RecoveredSigner.Hex(), key.Address().Hex())
+		}
+		if !report.Price.Equal(decimal.RequireFromString("0.5")) {
+			t.Fatalf("type %d implied price %s", sigType, report.Price)
+		}
+	}
+}
+
+func TestVerifySignedOrderReportsIssues(t *testing.T) {
+	key := mustSigner(t)
+	proxy := auth.SignatureProxy
+	signed, err := signOrderWithCreds(key, &auth.APIKey{Key: "api-key"}, verifyTestOrder(), &proxy, nil, nil)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	wrongMaker := *signed
+	wrongMaker.Order.Maker = key.Address()
+	report, err := VerifySignedOrder(&wrongMaker, 137)
+	if !errors.Is(err, sdkerrors.ErrInvalidSignature) {
+		t.Fatalf("expected ErrInvalidSignature, got %v", err)
+	}
+	// Changing the maker changes the digest, so the signer no longer recovers.
+	if report.Valid() || !strings.Contains(strings.Join(report.Issues, "\n"), "signature recovers to") {
+		t.Fatalf("unexpected issues: %v", report.Issues)
+	}
+
+	bad := *signed
+	bad.Order.Salt = types.U256{Int: new(big.Int).Lsh(big.NewInt(1), 60)}
+	bad.Order.TakerAmount = decimal.RequireFromString("1.5")
+	report, _ = VerifySignedOrder(&bad, 137)
+	issues := strings.Join(report.Issues, "\n")
+	for _, want := range []string{"salt", "taker_amount", "signature recovers to"} {
+		if !strings.Contains(issues, want) {
+			t.Fatalf("expected %q issue, got %v", want, report.Issues)
+		}
+	}
+}
+
+func TestVerifySignedOrderPoly1271Tampering(t *testing.T) {
+	key := mustSigner(t)
+	poly := auth.SignaturePoly1271
+	order := verifyTestOrder()
+	order.Maker = common.HexToAddress("0x9c90cad21cb08320Fb224EAb032dDAE311c017Ef")
+	signed, err := signOrderWithCreds(key, &auth.APIKey{Key: "api-key"}, order, &poly, nil, nil)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	report, err := VerifySignedOrder(signed, 137)
+	if err == nil || !strings.Contains(strings.Join(report.Issues, "\n"), "owner") {
+		t.Fatalf("expected unverified owner issue, got %v", report.Issues)
+	}
+	other := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	report, err = VerifySignedOrder(signed, 137, WithExpectedOwner(other))
+	if err == nil || !strings.Contains(strings.Join(report.Issues, "\n"), "expected owner") {
+		t.Fatalf("expected owner mismatch, got %v", report.Issues)
+	}
+
+	truncated := *signed
+	truncated.Signature = signed.Signature[:len(signed.Signature)-4]
+	if _, err := VerifySignedOrder(&truncated, 137); err == nil {
+		t.Fatal("expected truncated signature to fail")
+	}
+
+	tampered := *signed
+	tampered.Order.TakerAmount = decimal.NewFromInt(20_000_000)
+	report, err = VerifySignedOrder(&tampered, 137, WithExpectedOwner(key.Address()))
+	if err == nil || !strings.Contains(strings.Join(report.Issues, "\n"), "contents hash") {
+		t.Fatalf("expected contents hash mismatch, got %v", report.Issues)
+	}
+	if report.RecoveredSigner == key.Address() {
+		t.Fatal("tampered order should not recover the owner")
+	}
+}
@@ -0,0 +1,269 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+// cachedTime memoizes the parsed form of a timestamp string field.
+type cachedTime struct {
+	raw   string
+	value time.Time
+	err   error
+	ok    bool
+}
+
+func newCachedTime(raw string) cachedTime {
+	value, err := types.ParseTimestamp(raw)
+	return cachedTime{raw: raw, value: value, err: err, ok: true}
+}
+
+func (c cachedTime) get(raw string) (time.Time, error) {
+	if c.ok && c.raw == raw {
+		return c.value, c.err
+	}
+	return types.ParseTimestamp(raw)
+}
+
+func (c cachedTime) check(field, raw string) error {
+	if _, err := c.get(raw); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+func timeOf(c cachedTime, raw string) time.Time {
+	value, _ := c.get(raw)
+	return value
+}
+
+// Orderbook levels and events parse on demand rather than caching, so that
+// decoded books still compare equal to literal ones.
+
+// PriceDec returns Price as a decimal.
+func (l OrderbookLevel) PriceDec() decimal.Decimal { return decimalOf(l.Price) }
+
+// SizeDec returns Size as a decimal.
+func (l OrderbookLevel) SizeDec() decimal.Decimal { return decimalOf(l.Size) }
+
+// Validate reports fields that could not be parsed.
+func (l OrderbookLevel) Validate() error {
+	return errors.Join(checkDecimal("price", l.Price), checkDecimal("size", l.Size))
+}
+
+// Time returns Timestamp as a time.Time, accepting both second and millisecond resolution.
+func (e OrderbookEvent) Time() time.Time {
+	value, _ := types.ParseTimestamp(e.Timestamp)
+	return value
+}
+
+// Validate reports fields, including those of every level, that could not be parsed.
+func (e OrderbookEvent) Validate() error {
+	var errs []error
+	if _, err := types.ParseTimestamp(e.Timestamp); err != nil {
+		errs = append(errs, fmt.Errorf("timestamp: %w", err))
+	}
+	for i, level := range e.Bids {
+		if err := level.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("bids[%d]: %w", i, err))
+		}
+	}
+	for i, level := range e.Asks {
+		if err := level.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("asks[%d]: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func decimalOf(raw string) decimal.Decimal {
+	value, _ := types.ParseDecimal(raw)
+	return value
+}
+
+func checkDecimal(field, raw string) error {
+	if _, err := types.ParseDecimal(raw); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the event and parses its numeric fields once.
+func (e *PriceChangeEvent) UnmarshalJSON(data []byte) error {
+	type alias PriceChangeEvent
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*e = PriceChangeEvent(decoded)
+	e.price = types.NewCachedDecimal(e.Price)
+	e.size = types.NewCachedDecimal(e.Size)
+	e.bestBid = types.NewCachedDecimal(e.BestBid)
+	e.bestAsk = types.NewCachedDecimal(e.BestAsk)
+	e.timestamp = newCachedTime(e.Timestamp)
+	return nil
+}
+
+// setTimestamp overrides Timestamp and refreshes its cached form.
+func (e *PriceChangeEvent) setTimestamp(raw string) {
+	e.Timestamp = raw
+	e.timestamp = newCachedTime(raw)
+}
+
+// PriceDec returns Price as a decimal.
+func (e PriceChangeEvent) PriceDec() decimal.Decimal { return e.price.Value(e.Price) }
+
+// SizeDec returns Size as a decimal.
+func (e PriceChangeEvent) SizeDec() decimal.Decimal { return e.size.Value(e.Size) }
+
+// BestBidDec returns BestBid as a decimal.
+func (e PriceChangeEvent) BestBidDec() decimal.Decimal { return e.bestBid.Value(e.BestBid) }
+
+// BestAskDec returns BestAsk as a decimal.
+func (e PriceChangeEvent) BestAskDec() decimal.Decimal { return e.bestAsk.Value(e.BestAsk) }
+
+// Time returns Timestamp as a time.Time, accepting both second and millisecond resolution.
+func (e PriceChangeEvent) Time() time.Time { return timeOf(e.timestamp, e.Timestamp) }
+
+// Validate reports fields that could not be parsed.
+func (e PriceChangeEvent) Validate() error {
+	return errors.Join(
+		e.price.Validate("price", e.Price),
+		e.size.Validate("size", e.Size),
+		e.bestBid.Validate("best_bid", e.BestBid),
+		e.bestAsk.Validate("best_ask", e.BestAsk),
+		e.timestamp.check("timestamp", e.Timestamp),
+	)
+}
+
+// UnmarshalJSON decodes the event and parses its numeric fields once.
+func (e *LastTradePriceEvent) UnmarshalJSON(data []byte) error {
+	type alias LastTradePriceEvent
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*e = LastTradePriceEvent(decoded)
+	e.price = types.NewCachedDecimal(e.Price)
+	e.size = types.NewCachedDecimal(e.Size)
+	e.feeRateBps = types.NewCachedDecimal(e.FeeRateBps)
+	e.timestamp = newCachedTime(e.Timestamp)
+	return nil
+}
+
+// PriceDec returns Price as a decimal.
+func (e LastTradePriceEvent) PriceDec() decimal.Decimal { return e.price.Value(e.Price) }
+
+// SizeDec returns Size as a decimal.
+func (e LastTradePriceEvent) SizeDec() decimal.Decimal { return e.size.Value(e.Size) }
+
+// FeeRateBpsDec returns FeeRateBps as a decimal.
+func (e LastTradePriceEvent) FeeRateBpsDec() decimal.Decimal {
+	return e.feeRateBps.Value(e.FeeRateBps)
+}
+
+// Time returns Timestamp as a time.Time, accepting both second and millisecond resolution.
+func (e LastTradePriceEvent) Time() time.Time { return timeOf(e.timestamp, e.Timestamp) }
+
+// Validate reports fields that could not be parsed.
+func (e LastTradePriceEvent) Validate() error {
+	return errors.Join(
+		e.price.Validate("price", e.Price),
+		e.size.Validate("size", e.Size),
+		e.feeRateBps.Validate("fee_rate_bps", e.FeeRateBps),
+		e.timestamp.check("timestamp", e.Timestamp),
+	)
+}
+
+// UnmarshalJSON decodes the event and parses its numeric fields once.
+func (e *BestBidAskEvent) UnmarshalJSON(data []byte) error {
+	type alias BestBidAskEvent
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*e = BestBidAskEvent(decoded)
+	e.bestBid = types.NewCachedDecimal(e.BestBid)
+	e.bestAsk = types.NewCachedDecimal(e.BestAsk)
+	e.spread = types.NewCachedDecimal(e.Spread)
+	e.timestamp = newCachedTime(e.Timestamp)
+	return nil
+}
+
+// BestBidDec returns BestBid as a decimal.
+func (e BestBidAskEvent) BestBidDec() decimal.Decimal { return e.bestBid.Value(e.BestBid) }
+
+// BestAskDec returns BestAsk as a decimal.
+func (e BestBidAskEvent) BestAskDec() decimal.Decimal { return e.bestAsk.Value(e.BestAsk) }
+
+// SpreadDec returns Spread as a decimal.
+func (e BestBidAskEvent) SpreadDec() decimal.Decimal { return e.spread.Value(e.Spread) }
+
+// Time returns Timestamp as a time.Time, accepting both second and millisecond resolution.
+func (e BestBidAskEvent) Time() time.Time { return timeOf(e.timestamp, e.Timestamp) }
+
+// Validate reports fields that could not be parsed.
+func (e BestBidAskEvent) Validate() error {
+	return errors.Join(
+		e.bestBid.Validate("best_bid", e.BestBid),
+		e.bestAsk.Validate("best_ask", e.BestAsk),
+		e.spread.Validate("spread", e.Spread),
+		e.timestamp.check("timestamp", e.Timestamp),
+	)
+}
+
+// UnmarshalJSON decodes the event and parses its numeric fields once.
+func (e *OrderEvent) UnmarshalJSON(data []byte) error {
+	type alias OrderEvent
+	var decoded alias
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	*e = OrderEvent(decoded)
+	e.price = types.NewCachedDecimal(e.Price)
+	e.originalSize = types.NewCachedDecimal(e.OriginalSize)
+	e.sizeMatched = types.NewCachedDecimal(e.SizeMatched)
+	e.timestamp = newCachedTime(e.Timestamp)
+	e.createdAt = newCachedTime(e.CreatedAt)
+	return nil
+}
+
+// PriceDec returns Price as a decimal.
+func (e OrderEvent) PriceDec() decimal.Decimal { return e.price.Value(e.Price) }
+
+// OriginalSizeDec returns OriginalSize as a decimal.
+func (e OrderEvent) OriginalSizeDec() decimal.Decimal {
+	return e.originalSize.Value(e.OriginalSize)
+}
+
+// SizeMatchedDec returns SizeMatched as a decimal.
+func (e OrderEvent) SizeMatchedDec() decimal.Decimal {
+	return e.sizeMatched.Value(e.SizeMatched)
+}
+
+// RemainingSizeDec returns the unmatched part of the order.
+func (e OrderEvent) RemainingSizeDec() decimal.Decimal {
+	return e.OriginalSizeDec().Sub(e.SizeMatchedDec())
+}
+
+// Time returns Timestamp as a time.Time, accepting both second and millisecond resolution.
+func (e OrderEvent) Time() time.Time { return timeOf(e.timestamp, e.Timestamp) }
+
+// CreatedAtTime returns CreatedAt as a time.Time, accepting both second and millisecond resolution.
+func (e OrderEvent) CreatedAtTime() time.Time { return timeOf(e.createdAt, e.CreatedAt) }
+
+// Validate reports fields that could not be parsed.
+func (e OrderEvent) Validate() error {
+	return errors.Join(
+		e.price.Validate("price", e.Price),
+		e.originalSize.Validate("original_size", e.OriginalSize),
+		e.sizeMatched.Validate("size_matched", e.SizeMatched),
+		e.timestamp.check("timestamp", e.Timestamp),
+		e.createdAt.check("created_at", e.CreatedAt),
+	)
+}
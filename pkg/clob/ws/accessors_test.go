@@ -0,0 +1,149 @@
+package ws
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOrderbookLevelAccessors(t *testing.T) {
+	var level OrderbookLevel
+	if err := json.Unmarshal([]byte(`{"price":"0.45","size":"120.5"}`), &level); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if got := level.PriceDec().String(); got != "0.45" {
+		t.Fatalf("expected price 0.45, got %s", got)
+	}
+	if got := level.SizeDec().String(); got != "120.5" {
+		t.Fatalf("expected size 120.5, got %s", got)
+	}
+	if err := level.Validate(); err != nil {
+		t.Fatalf("unexpected validate error: %v", err)
+	}
+}
+
+func TestOrderbookDecodedEqualsLiteral(t *testing.T) {
+	var book OrderbookEvent
+	raw := `{"asset_id":"a","bids":[{"price":"0.45","size":"10"}],"asks":[],"timestamp":"1700000000000"}`
+	if err := json.Unmarshal([]byte(raw), &book); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if book.Bids[0] != (OrderbookLevel{Price: "0.45", Size: "10"}) {
+		t.Fatalf("decoded level differs from literal: %+v", book.Bids[0])
+	}
+	want := OrderbookEvent{AssetID: "a", Bids: []OrderbookLevel{{Price: "0.45", Size: "10"}}, Asks: []OrderbookLevel{}, Timestamp: "1700000000000"}
+	if !reflect.DeepEqual(book, want) {
+		t.Fatalf("decoded book differs from literal: %+v", book)
+	}
+	if !book.Time().Equal(time.UnixMilli(1700000000000)) {
+		t.Fatalf("unexpected time %s", book.Time())
+	}
+}
+
+func TestPriceChangeEventAccessors_MixedTimestamps(t *testing.T) {
+	want := time.Unix(1700000000, 0).UTC()
+	for _, ts := range []string{"1700000000", "1700000000000"} {
+		var ev PriceChangeEvent
+		raw := `{"asset_id":"tok","price":"0.5","size":"10","best_bid":"0.49","best_ask":"0.51","timestamp":"` + ts + `"}`
+		if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+			t.Fatalf("unmarshal failed: %v", err)
+		}
+		if !ev.Time().Equal(want) {
+			t.Fatalf("timestamp %s: expected %s, got %s", ts, want, ev.Time())
+		}
+		if got := ev.BestAskDec().Sub(ev.BestBidDec()).String(); got != "0.02" {
+			t.Fatalf("expected spread 0.02, got %s", got)
+		}
+	}
+}
+
+func TestPriceChangeEventAccessors_SetTimestamp(t *testing.T) {
+	var ev PriceChangeEvent
+	if err := json.Unmarshal([]byte(`{"asset_id":"tok","price":"0.5"}`), &ev); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if !ev.Time().IsZero() {
+		t.Fatalf("expected zero time, got %s", ev.Time())
+	}
+	ev.setTimestamp("1700000000000")
+	if ev.Time().Unix() != 1700000000 {
+		t.Fatalf("expected refreshed timestamp, got %s", ev.Time())
+	}
+}
+
+func TestLastTradePriceEventAccessors_InvalidValue(t *testing.T) {
+	var ev LastTradePriceEvent
+	if err := json.Unmarshal([]byte(`{"asset_id":"tok","price":"abc","size":"5"}`), &ev); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if !ev.PriceDec().IsZero() {
+		t.Fatalf("expected zero price for invalid value, got %s", ev.PriceDec())
+	}
+	if ev.SizeDec().String() != "5" {
+		t.Fatalf("expected size 5, got %s", ev.SizeDec())
+	}
+	if err := ev.Validate(); err == nil {
+		t.Fatal("expected validate error for invalid price")
+	}
+}
+
+func TestBestBidAskEventAccessors(t *testing.T) {
+	ev := BestBidAskEvent{BestBid: "0.4", BestAsk: "0.6", Spread: "0.2", Timestamp: "1700000000123"}
+	if ev.SpreadDec().String() != "0.2" {
+		t.Fatalf("expected spread 0.2, got %s", ev.SpreadDec())
+	}
+	if ev.Time().UnixMilli() != 1700000000123 {
+		t.Fatalf("unexpected time %s", ev.Time())
+	}
+	if err := ev.Validate(); err != nil {
+		t.Fatalf("unexpected validate error: %v", err)
+	}
+}
+
+func TestOrderEventAccessors(t *testing.T) {
+	var ev OrderEvent
+	raw := `{"id":"o1","price":"0.55","original_size":"100","size_matched":"40","timestamp":"1700000000","created_at":"1699999999"}`
+	if err := json.Unmarshal([]byte(raw), &ev); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if ev.RemainingSizeDec().String() != "60" {
+		t.Fatalf("expected remaining 60, got %s", ev.RemainingSizeDec())
+	}
+	if ev.CreatedAtTime().Unix() != 1699999999 {
+		t.Fatalf("unexpected created at %s", ev.CreatedAtTime())
+	}
+	if err := ev.Validate(); err != nil {
+		t.Fatalf("unexpected validate error: %v", err)
+	}
+}
+
+func TestProcessEvent_BookAccessors(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan OrderbookEvent, 1)
+	c.orderbookSubs["ob1"] = &subscriptionEntry[OrderbookEvent]{
+		id: "ob1", ch: ch, errCh: make(chan error, 1),
+	}
+	c.processEvent(map[string]interface{}{
+		"event_type": "book",
+		"asset_id":   "tok1",
+		"buys":       []interface{}{map[string]interface{}{"price": "0.5", "size": "10"}},
+		"sells":      []interface{}{map[string]interface{}{"price": "0.6", "size": "12"}},
+		"timestamp":  "1700000000000",
+	})
+
+	select {
+	case ev := <-ch:
+		if ev.Bids[0].PriceDec().String() != "0.5" || ev.Asks[0].SizeDec().String() != "12" {
+			t.Fatalf("unexpected levels %+v", ev)
+		}
+		if ev.Time().Unix() != 1700000000 {
+			t.Fatalf("unexpected time %s", ev.Time())
+		}
+		if err := ev.Validate(); err != nil {
+			t.Fatalf("unexpected validate error: %v", err)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout")
+	}
+}
@@ -21,6 +21,8 @@ type Client interface {
 
 	// ConnectionState returns the current status of a specific WebSocket channel.
 	ConnectionState(channel Channel) ConnectionState
+	// Stats returns a diagnostics snapshot for a specific WebSocket channel.
+	Stats(channel Channel) ChannelStats
 	// ConnectionStateStream returns a stream of connection state transition events.
 	ConnectionStateStream(ctx context.Context) (*Stream[ConnectionStateEvent], error)
 	// Close gracefully shuts down all active WebSocket connections and closes all event channels.
@@ -76,6 +78,12 @@ type Client interface {
 	SubscribeUserOrdersStream(ctx context.Context, markets []string) (*Stream[OrderEvent], error)
 	// SubscribeUserTradesStream is like SubscribeUserTrades but returns a managed Stream object.
 	SubscribeUserTradesStream(ctx context.Context, markets []string) (*Stream[TradeEvent], error)
+	// SubscribeMarketEvents multiplexes market events for specific assets into a single stream that
+	// preserves server arrival order. All kinds in MarketEventKinds are delivered when kinds is empty.
+	SubscribeMarketEvents(ctx context.Context, assetIDs []string, kinds ...EventType) (*Stream[MarketEvent], error)
+	// SubscribeUserEvents multiplexes order and trade events for the authenticated account into a
+	// single stream that preserves server arrival order. Requires an API key to be configured on the client.
+	SubscribeUserEvents(ctx context.Context, markets []string, kinds ...EventType) (*Stream[UserEvent], error)
 
 	// -- Low-level Subscription Control --
 
@@ -2,15 +2,24 @@ package ws
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
 
 func (c *clientImpl) processEvent(raw map[string]interface{}) {
+	c.processEventAt("", raw, time.Now())
+}
+
+// processEventAt decodes and dispatches a single event received on channel at receivedAt.
+func (c *clientImpl) processEventAt(channel Channel, raw map[string]interface{}, receivedAt time.Time) {
 	eventType, _ := raw["event_type"].(string)
 	if eventType == "" {
 		eventType, _ = raw["type"].(string)
 	}
+	if stats := c.statsFor(channel); stats != nil {
+		stats.recordEvent(eventType)
+	}
 
 	// Re-marshal to bytes to use existing logic or decode from map directly
 	// For simplicity, let's just use the map or re-marshal for struct decoding
@@ -45,13 +54,15 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 				event.Asks = wire.Sells
 			}
 			c.dispatchOrderbook(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: Orderbook, ReceivedAt: receivedAt, Orderbook: &event})
 
 			if len(event.Bids) > 0 && len(event.Asks) > 0 {
-				bid, bidErr := decimal.NewFromString(event.Bids[0].Price)
-				ask, askErr := decimal.NewFromString(event.Asks[0].Price)
-				if bidErr == nil && askErr == nil {
-					mid := bid.Add(ask).Div(decimal.NewFromInt(2))
-					c.dispatchMidpoint(MidpointEvent{AssetID: event.AssetID, Midpoint: mid.String()})
+				bid, ask := event.Bids[0], event.Asks[0]
+				if bid.Validate() == nil && ask.Validate() == nil {
+					mid := bid.PriceDec().Add(ask.PriceDec()).Div(decimal.NewFromInt(2))
+					midEvent := MidpointEvent{AssetID: event.AssetID, Midpoint: mid.String()}
+					c.dispatchMidpoint(midEvent)
+					c.dispatchMarketEvent(MarketEvent{Kind: Midpoint, ReceivedAt: receivedAt, Midpoint: &midEvent})
 				}
 			}
 		}
@@ -59,26 +70,34 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 		var event PriceEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchPrice(event)
+			for _, priceChange := range event.PriceChanges {
+				priceChange.setTimestamp(event.Timestamp)
+				c.dispatchMarketEvent(MarketEvent{Kind: PriceChange, ReceivedAt: receivedAt, PriceChange: &priceChange})
+			}
 		}
 	case "midpoint":
 		var event MidpointEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchMidpoint(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: Midpoint, ReceivedAt: receivedAt, Midpoint: &event})
 		}
 	case "last_trade_price":
 		var event LastTradePriceEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchLastTrade(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: LastTradePrice, ReceivedAt: receivedAt, LastTradePrice: &event})
 		}
 	case "tick_size_change":
 		var event TickSizeChangeEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchTickSize(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: TickSizeChange, ReceivedAt: receivedAt, TickSizeChange: &event})
 		}
 	case "best_bid_ask":
 		var event BestBidAskEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchBestBidAsk(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: BestBidAsk, ReceivedAt: receivedAt, BestBidAsk: &event})
 		}
 	case "new_market":
 		var wire struct {
@@ -110,6 +129,7 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 				Timestamp:    wire.Timestamp,
 			}
 			c.dispatchNewMarket(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: NewMarket, ReceivedAt: receivedAt, NewMarket: &event})
 		}
 	case "market_resolved":
 		var wire struct {
@@ -145,16 +165,19 @@ func (c *clientImpl) processEvent(raw map[string]interface{}) {
 				Timestamp:      wire.Timestamp,
 			}
 			c.dispatchMarketResolved(event)
+			c.dispatchMarketEvent(MarketEvent{Kind: MarketResolved, ReceivedAt: receivedAt, MarketResolved: &event})
 		}
 	case "trade", "trades":
 		var event TradeEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchTrade(event)
+			c.dispatchUserEvent(UserEvent{Kind: UserTrades, ReceivedAt: receivedAt, Trade: &event})
 		}
 	case "order", "orders":
 		var event OrderEvent
 		if err := json.Unmarshal(msgBytes, &event); err == nil {
 			c.dispatchOrder(event)
+			c.dispatchUserEvent(UserEvent{Kind: UserOrders, ReceivedAt: receivedAt, Order: &event})
 		}
 	}
 }
@@ -198,7 +221,7 @@ func (c *clientImpl) dispatchPrice(event PriceEvent) {
 	for _, sub := range subs {
 		for _, priceChange := range event.PriceChanges {
 			if sub.matchesAsset(priceChange.AssetID) {
-				priceChange.Timestamp = event.Timestamp
+				priceChange.setTimestamp(event.Timestamp)
 				sub.trySend(priceChange)
 			}
 		}
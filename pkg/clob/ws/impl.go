@@ -27,6 +27,7 @@ type clientImpl struct {
 	userURL      string
 	conn         *websocket.Conn
 	userConn     *websocket.Conn
+	authMu       sync.RWMutex // guards signer and apiKey
 	signer       auth.Signer
 	apiKey       *auth.APIKey
 	mu           sync.Mutex
@@ -57,6 +58,10 @@ type clientImpl struct {
 	lastPongMarket atomic.Int64
 	lastPongUser   atomic.Int64
 
+	// Diagnostics
+	marketStats channelStats
+	userStats   channelStats
+
 	subMu          sync.Mutex
 	marketRefs     map[string]int
 	userRefs       map[string]int
@@ -81,6 +86,8 @@ type clientImpl struct {
 	tradeSubs          map[string]*subscriptionEntry[TradeEvent]
 	orderSubs          map[string]*subscriptionEntry[OrderEvent]
 	stateSubs          map[string]*subscriptionEntry[ConnectionStateEvent]
+	marketEventSubs    map[string]*subscriptionEntry[MarketEvent]
+	userEventSubs      map[string]*subscriptionEntry[UserEvent]
 
 	// Channels
 	orderbookCh      chan OrderbookEvent
@@ -145,6 +152,8 @@ func newClientImpl(url string, signer auth.Signer, apiKey *auth.APIKey, cfg Clie
 		tradeSubs:           make(map[string]*subscriptionEntry[TradeEvent]),
 		orderSubs:           make(map[string]*subscriptionEntry[OrderEvent]),
 		stateSubs:           make(map[string]*subscriptionEntry[ConnectionStateEvent]),
+		marketEventSubs:     make(map[string]*subscriptionEntry[MarketEvent]),
+		userEventSubs:       make(map[string]*subscriptionEntry[UserEvent]),
 		orderbookCh:         make(chan OrderbookEvent, 100),
 		priceCh:             make(chan PriceEvent, 100),
 		midpointCh:          make(chan MidpointEvent, 100),
@@ -179,7 +188,8 @@ func (c *clientImpl) Clone() Client {
 		HeartbeatTimeout:    c.heartbeatTimeout,
 		ReadTimeout:         time.Duration(c.readTimeout.Load()),
 	}
-	clone := newClientImpl(c.baseURL, c.signer, c.apiKey, cfg)
+	signer, apiKey := c.credentials()
+	clone := newClientImpl(c.baseURL, signer, apiKey, cfg)
 	if auth := c.getLastAuth(); auth != nil {
 		clone.lastAuth = auth
 	}
@@ -187,8 +197,7 @@ func (c *clientImpl) Clone() Client {
 }
 
 func (c *clientImpl) Authenticate(signer auth.Signer, apiKey *auth.APIKey) Client {
-	c.signer = signer
-	c.apiKey = apiKey
+	c.setCredentials(signer, apiKey)
 	c.subMu.Lock()
 	c.lastAuth = nil
 	c.subMu.Unlock()
@@ -196,8 +205,7 @@ func (c *clientImpl) Authenticate(signer auth.Signer, apiKey *auth.APIKey) Clien
 }
 
 func (c *clientImpl) Deauthenticate() Client {
-	c.signer = nil
-	c.apiKey = nil
+	c.setCredentials(nil, nil)
 	c.subMu.Lock()
 	c.lastAuth = nil
 	c.subMu.Unlock()
@@ -205,6 +213,19 @@ func (c *clientImpl) Deauthenticate() Client {
 	return c
 }
 
+func (c *clientImpl) credentials() (auth.Signer, *auth.APIKey) {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.signer, c.apiKey
+}
+
+func (c *clientImpl) setCredentials(signer auth.Signer, apiKey *auth.APIKey) {
+	c.authMu.Lock()
+	c.signer = signer
+	c.apiKey = apiKey
+	c.authMu.Unlock()
+}
+
 func normalizeWSURLs(raw string) (string, string, string) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -251,11 +272,13 @@ func (c *clientImpl) pingLoop(channel Channel) {
 					if c.debug {
 						logger.Warn("heartbeat timeout on %s (last pong %s)", channel, last.Format(time.RFC3339))
 					}
+					c.statsFor(channel).markDisconnect("heartbeat timeout")
 					c.closeConn(channel)
 					return
 				}
 			}
 			// CLOB WS uses "PING" string for Keep-Alive
+			c.statsFor(channel).recordPing(time.Now())
 			err := c.writeMessage(channel, []byte("PING"))
 			if err != nil {
 				return
@@ -387,6 +410,10 @@ func (c *clientImpl) readLoop(channel Channel) {
 			continue
 		}
 		_, message, err := conn.ReadMessage()
+		receivedAt := time.Now()
+		if err == nil {
+			c.statsFor(channel).recordFrame(len(message), receivedAt)
+		}
 		if err != nil {
 			if c.closing.Load() {
 				break
@@ -395,6 +422,7 @@ func (c *clientImpl) readLoop(channel Channel) {
 				if c.debug {
 					logger.Debug("read error: %v (reconnecting)", err)
 				}
+				c.statsFor(channel).recordDisconnect(err)
 				if err := c.reconnectLoop(channel); err == nil {
 					// Reconnection successful - a new readLoop has been started
 					// Exit this readLoop to avoid multiple goroutines reading from the same connection
@@ -414,6 +442,7 @@ func (c *clientImpl) readLoop(channel Channel) {
 
 		// Check for PONG
 		if string(message) == "PONG" {
+			c.statsFor(channel).recordPong(receivedAt)
 			if c.debug {
 				logger.Debug("Received PONG")
 			}
@@ -432,14 +461,14 @@ func (c *clientImpl) readLoop(channel Channel) {
 		// Try unmarshal as array first
 		if err := json.Unmarshal(message, &rawArr); err == nil {
 			for _, item := range rawArr {
-				c.processEvent(item)
+				c.processEventAt(channel, item, receivedAt)
 			}
 			continue
 		}
 
 		// Try unmarshal as single object
 		if err := json.Unmarshal(message, &rawObj); err == nil {
-			c.processEvent(rawObj)
+			c.processEventAt(channel, rawObj, receivedAt)
 			continue
 		}
 	}
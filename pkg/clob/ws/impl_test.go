@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 )
 
 // --------------- normalizeWSURLs ---------------
@@ -253,7 +254,7 @@ func TestStream_Close_Nil(t *testing.T) {
 }
 
 func TestStream_Close_NilCloseF(t *testing.T) {
-	s := &Stream[int]{closeF: nil}
+	s := stream.New[int](nil, nil, nil)
 	if err := s.Close(); err != nil {
 		t.Fatalf("nil closeF should not error: %v", err)
 	}
@@ -261,7 +262,7 @@ func TestStream_Close_NilCloseF(t *testing.T) {
 
 func TestStream_Close_Normal(t *testing.T) {
 	called := false
-	s := &Stream[int]{closeF: func() error { called = true; return nil }}
+	s := stream.New[int](nil, nil, func() error { called = true; return nil })
 	if err := s.Close(); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -401,6 +402,8 @@ func newTestClient() *clientImpl {
 		tradeSubs:          make(map[string]*subscriptionEntry[TradeEvent]),
 		orderSubs:          make(map[string]*subscriptionEntry[OrderEvent]),
 		stateSubs:          make(map[string]*subscriptionEntry[ConnectionStateEvent]),
+		marketEventSubs:    make(map[string]*subscriptionEntry[MarketEvent]),
+		userEventSubs:      make(map[string]*subscriptionEntry[UserEvent]),
 		orderbookCh:        make(chan OrderbookEvent, 100),
 		priceCh:            make(chan PriceEvent, 100),
 		midpointCh:         make(chan MidpointEvent, 100),
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/logger"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 	"github.com/gorilla/websocket"
 )
 
@@ -138,6 +139,7 @@ func (c *clientImpl) reconnectLoop(channel Channel) error {
 			}
 
 			c.resubscribe(channel)
+			c.statsFor(channel).recordReconnect()
 			return nil
 		}
 
@@ -174,6 +176,10 @@ func (c *clientImpl) resubscribe(channel Channel) {
 		}
 		_ = c.writeJSON(ChannelMarket, req)
 	case ChannelUser:
+		if auth == nil {
+			// Authenticate clears the last payload; use the current key.
+			auth = c.authPayload()
+		}
 		if len(markets) == 0 || auth == nil {
 			return
 		}
@@ -230,6 +236,8 @@ func (c *clientImpl) closeAllStreams() {
 	closeSubMap(c.marketResolvedSubs)
 	closeSubMap(c.tradeSubs)
 	closeSubMap(c.orderSubs)
+	closeSubMap(c.marketEventSubs)
+	closeSubMap(c.userEventSubs)
 	c.subMu.Unlock()
 
 	c.stateMu.Lock()
@@ -316,19 +324,15 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 	user := c.userState
 	c.stateMu.Unlock()
 
-	stream := &Stream[ConnectionStateEvent]{
-		C:   entry.ch,
-		Err: entry.errCh,
-		closeF: func() error {
-			if entry.close() {
-				c.stateMu.Lock()
-				delete(c.stateSubs, entry.id)
-				c.stateMu.Unlock()
-			}
-			return nil
-		},
-	}
-	bindContext(ctx, stream)
+	out := stream.New(entry.ch, entry.errCh, func() error {
+		if entry.close() {
+			c.stateMu.Lock()
+			delete(c.stateSubs, entry.id)
+			c.stateMu.Unlock()
+		}
+		return nil
+	})
+	bindContext(ctx, out)
 	entry.trySend(ConnectionStateEvent{
 		Channel:  ChannelMarket,
 		State:    market,
@@ -339,7 +343,7 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 		State:    user,
 		Recorded: time.Now().UnixMilli(),
 	})
-	return stream, nil
+	return out, nil
 }
 
 func (c *clientImpl) setConnState(channel Channel, state ConnectionState, attempt int) {
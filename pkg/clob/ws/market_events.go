@@ -0,0 +1,259 @@
+package ws
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// MarketEvent is a tagged union of market channel events. Kind selects which
+// payload field is set; the others are nil.
+type MarketEvent struct {
+	Kind       EventType
+	Channel    Channel
+	ReceivedAt time.Time
+
+	Orderbook      *OrderbookEvent
+	PriceChange    *PriceChangeEvent
+	Midpoint       *MidpointEvent
+	LastTradePrice *LastTradePriceEvent
+	TickSizeChange *TickSizeChangeEvent
+	BestBidAsk     *BestBidAskEvent
+	NewMarket      *NewMarketEvent
+	MarketResolved *MarketResolvedEvent
+}
+
+// Value returns the payload as a concrete value for use in a type switch.
+func (e MarketEvent) Value() interface{} {
+	switch {
+	case e.Orderbook != nil:
+		return *e.Orderbook
+	case e.PriceChange != nil:
+		return *e.PriceChange
+	case e.Midpoint != nil:
+		return *e.Midpoint
+	case e.LastTradePrice != nil:
+		return *e.LastTradePrice
+	case e.TickSizeChange != nil:
+		return *e.TickSizeChange
+	case e.BestBidAsk != nil:
+		return *e.BestBidAsk
+	case e.NewMarket != nil:
+		return *e.NewMarket
+	case e.MarketResolved != nil:
+		return *e.MarketResolved
+	default:
+		return nil
+	}
+}
+
+// AsOrderbook returns the orderbook payload when Kind is Orderbook.
+func (e MarketEvent) AsOrderbook() (OrderbookEvent, bool) { return deref(e.Orderbook) }
+
+// AsPriceChange returns the price change payload when Kind is PriceChange.
+func (e MarketEvent) AsPriceChange() (PriceChangeEvent, bool) { return deref(e.PriceChange) }
+
+// AsMidpoint returns the midpoint payload when Kind is Midpoint.
+func (e MarketEvent) AsMidpoint() (MidpointEvent, bool) { return deref(e.Midpoint) }
+
+// AsLastTradePrice returns the last trade price payload when Kind is LastTradePrice.
+func (e MarketEvent) AsLastTradePrice() (LastTradePriceEvent, bool) {
+	return deref(e.LastTradePrice)
+}
+
+// AsTickSizeChange returns the tick size payload when Kind is TickSizeChange.
+func (e MarketEvent) AsTickSizeChange() (TickSizeChangeEvent, bool) {
+	return deref(e.TickSizeChange)
+}
+
+// AsBestBidAsk returns the top-of-book payload when Kind is BestBidAsk.
+func (e MarketEvent) AsBestBidAsk() (BestBidAskEvent, bool) { return deref(e.BestBidAsk) }
+
+// AsNewMarket returns the new market payload when Kind is NewMarket.
+func (e MarketEvent) AsNewMarket() (NewMarketEvent, bool) { return deref(e.NewMarket) }
+
+// AsMarketResolved returns the resolution payload when Kind is MarketResolved.
+func (e MarketEvent) AsMarketResolved() (MarketResolvedEvent, bool) {
+	return deref(e.MarketResolved)
+}
+
+// AssetIDs returns the assets the event refers to.
+func (e MarketEvent) AssetIDs() []string {
+	switch {
+	case e.Orderbook != nil:
+		return []string{e.Orderbook.AssetID}
+	case e.PriceChange != nil:
+		return []string{e.PriceChange.AssetID}
+	case e.Midpoint != nil:
+		return []string{e.Midpoint.AssetID}
+	case e.LastTradePrice != nil:
+		return []string{e.LastTradePrice.AssetID}
+	case e.TickSizeChange != nil:
+		return []string{e.TickSizeChange.AssetID}
+	case e.BestBidAsk != nil:
+		return []string{e.BestBidAsk.AssetID}
+	case e.NewMarket != nil:
+		return e.NewMarket.AssetIDs
+	case e.MarketResolved != nil:
+		return e.MarketResolved.AssetIDs
+	default:
+		return nil
+	}
+}
+
+// UserEvent is a tagged union of user channel events. Kind selects which
+// payload field is set; the other is nil.
+type UserEvent struct {
+	Kind       EventType
+	Channel    Channel
+	ReceivedAt time.Time
+
+	Order *OrderEvent
+	Trade *TradeEvent
+}
+
+// Value returns the payload as a concrete value for use in a type switch.
+func (e UserEvent) Value() interface{} {
+	switch {
+	case e.Order != nil:
+		return *e.Order
+	case e.Trade != nil:
+		return *e.Trade
+	default:
+		return nil
+	}
+}
+
+// AsOrder returns the order payload when Kind is UserOrders.
+func (e UserEvent) AsOrder() (OrderEvent, bool) { return deref(e.Order) }
+
+// AsTrade returns the trade payload when Kind is UserTrades.
+func (e UserEvent) AsTrade() (TradeEvent, bool) { return deref(e.Trade) }
+
+// Market returns the market (condition ID) the event refers to.
+func (e UserEvent) Market() string {
+	switch {
+	case e.Order != nil:
+		return e.Order.Market
+	case e.Trade != nil:
+		return e.Trade.Market
+	default:
+		return ""
+	}
+}
+
+func deref[T any](v *T) (T, bool) {
+	if v == nil {
+		var zero T
+		return zero, false
+	}
+	return *v, true
+}
+
+// MarketEventKinds lists every kind deliverable by SubscribeMarketEvents.
+var MarketEventKinds = []EventType{
+	Orderbook,
+	PriceChange,
+	Midpoint,
+	LastTradePrice,
+	TickSizeChange,
+	BestBidAsk,
+	NewMarket,
+	MarketResolved,
+}
+
+// UserEventKinds lists every kind deliverable by SubscribeUserEvents.
+var UserEventKinds = []EventType{UserOrders, UserTrades}
+
+// customFeatureKinds require custom features to be enabled on the market subscription.
+var customFeatureKinds = map[EventType]struct{}{
+	BestBidAsk:     {},
+	NewMarket:      {},
+	MarketResolved: {},
+}
+
+func resolveKinds(requested []EventType, allowed []EventType) ([]EventType, error) {
+	if len(requested) == 0 {
+		return allowed, nil
+	}
+	valid := make(map[EventType]struct{}, len(allowed))
+	for _, kind := range allowed {
+		valid[kind] = struct{}{}
+	}
+	for _, kind := range requested {
+		if _, ok := valid[kind]; !ok {
+			return nil, fmt.Errorf("unsupported event kind %q", kind)
+		}
+	}
+	return requested, nil
+}
+
+func makeKindSet(kinds []EventType) map[EventType]struct{} {
+	set := make(map[EventType]struct{}, len(kinds))
+	for _, kind := range kinds {
+		set[kind] = struct{}{}
+	}
+	return set
+}
+
+func (c *clientImpl) SubscribeMarketEvents(ctx context.Context, assetIDs []string, kinds ...EventType) (*Stream[MarketEvent], error) {
+	resolved, err := resolveKinds(kinds, MarketEventKinds)
+	if err != nil {
+		return nil, err
+	}
+	custom := false
+	for _, kind := range resolved {
+		if _, ok := customFeatureKinds[kind]; ok {
+			custom = true
+			break
+		}
+	}
+	return subscribeMarketStreamKinds(c, ctx, assetIDs, MarketEvents, custom, makeKindSet(resolved), c.marketEventSubs)
+}
+
+func (c *clientImpl) SubscribeUserEvents(ctx context.Context, markets []string, kinds ...EventType) (*Stream[UserEvent], error) {
+	resolved, err := resolveKinds(kinds, UserEventKinds)
+	if err != nil {
+		return nil, err
+	}
+	return subscribeUserStreamKinds(c, ctx, markets, UserEvents, makeKindSet(resolved), c.userEventSubs)
+}
+
+func (c *clientImpl) dispatchMarketEvent(event MarketEvent) {
+	if c.closing.Load() {
+		return
+	}
+	event.Channel = ChannelMarket
+	c.subMu.Lock()
+	subs := snapshotSubs(c.marketEventSubs)
+	c.subMu.Unlock()
+	if len(subs) == 0 {
+		return
+	}
+	assets := event.AssetIDs()
+	for _, sub := range subs {
+		if sub.matchesKind(event.Kind) && sub.matchesAnyAsset(assets) {
+			sub.trySend(event)
+		}
+	}
+}
+
+func (c *clientImpl) dispatchUserEvent(event UserEvent) {
+	if c.closing.Load() {
+		return
+	}
+	event.Channel = ChannelUser
+	c.subMu.Lock()
+	subs := snapshotSubs(c.userEventSubs)
+	c.subMu.Unlock()
+	market := event.Market()
+	for _, sub := range subs {
+		if !sub.matchesKind(event.Kind) {
+			continue
+		}
+		if market != "" && !sub.matchesMarket(market) {
+			continue
+		}
+		sub.trySend(event)
+	}
+}
@@ -0,0 +1,170 @@
+package ws
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/gorilla/websocket"
+)
+
+func TestProcessEvent_MarketEventsPreserveOrder(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan MarketEvent, 10)
+	c.marketEventSubs["mux"] = &subscriptionEntry[MarketEvent]{
+		id: "mux", ch: ch, errCh: make(chan error, 5), assets: makeIDSet([]string{"tok1"}),
+	}
+
+	c.processEvent(map[string]interface{}{
+		"event_type": "book",
+		"asset_id":   "tok1",
+		"bids":       []interface{}{map[string]interface{}{"price": "0.4", "size": "10"}},
+		"asks":       []interface{}{map[string]interface{}{"price": "0.6", "size": "10"}},
+	})
+	c.processEvent(map[string]interface{}{
+		"event_type": "price_change",
+		"timestamp":  "1700000000000",
+		"price_changes": []interface{}{
+			map[string]interface{}{"asset_id": "tok1", "price": "0.5"},
+			map[string]interface{}{"asset_id": "other", "price": "0.1"},
+		},
+	})
+	c.processEvent(map[string]interface{}{"event_type": "last_trade_price", "asset_id": "tok1", "price": "0.55"})
+	c.processEvent(map[string]interface{}{"event_type": "market_resolved", "id": "m1", "assets_ids": []interface{}{"tok1", "tok2"}})
+
+	want := []EventType{Orderbook, Midpoint, PriceChange, LastTradePrice, MarketResolved}
+	for i, kind := range want {
+		select {
+		case ev := <-ch:
+			if ev.Kind != kind {
+				t.Fatalf("event %d: expected %s, got %s", i, kind, ev.Kind)
+			}
+			if ev.Channel != ChannelMarket {
+				t.Fatalf("event %d: expected market channel, got %s", i, ev.Channel)
+			}
+			if ev.ReceivedAt.IsZero() {
+				t.Fatalf("event %d: missing receive timestamp", i)
+			}
+			if ev.Value() == nil {
+				t.Fatalf("event %d: missing payload", i)
+			}
+			if kind == PriceChange {
+				pc, ok := ev.AsPriceChange()
+				if !ok || pc.AssetID != "tok1" || pc.Time().Unix() != 1700000000 {
+					t.Fatalf("unexpected price change %+v", pc)
+				}
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatalf("timeout waiting for event %d (%s)", i, kind)
+		}
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected extra event %s", ev.Kind)
+	default:
+	}
+}
+
+func TestProcessEvent_MarketEventsKindFilter(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan MarketEvent, 10)
+	c.marketEventSubs["mux"] = &subscriptionEntry[MarketEvent]{
+		id: "mux", ch: ch, errCh: make(chan error, 5), kinds: makeKindSet([]EventType{TickSizeChange}),
+	}
+
+	c.processEvent(map[string]interface{}{"event_type": "last_trade_price", "asset_id": "tok1", "price": "0.55"})
+	c.processEvent(map[string]interface{}{"event_type": "tick_size_change", "asset_id": "tok1", "tick_size": "0.001"})
+
+	select {
+	case ev := <-ch:
+		tick, ok := ev.AsTickSizeChange()
+		if !ok || tick.TickSize != "0.001" {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+		if _, ok := ev.AsLastTradePrice(); ok {
+			t.Fatal("expected AsLastTradePrice to report false")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timeout")
+	}
+	if len(ch) != 0 {
+		t.Fatalf("expected filtered kinds to be dropped, got %d extra", len(ch))
+	}
+}
+
+func TestProcessEvent_UserEvents(t *testing.T) {
+	c := newTestClient()
+	ch := make(chan UserEvent, 10)
+	c.userEventSubs["mux"] = &subscriptionEntry[UserEvent]{
+		id: "mux", ch: ch, errCh: make(chan error, 5), markets: makeIDSet([]string{"m1"}),
+	}
+
+	c.processEvent(map[string]interface{}{"event_type": "order", "id": "o1", "market": "m1"})
+	c.processEvent(map[string]interface{}{"event_type": "trade", "id": "t1", "market": "m2"})
+	c.processEvent(map[string]interface{}{"event_type": "trade", "id": "t2", "market": "m1"})
+
+	first := <-ch
+	if order, ok := first.AsOrder(); !ok || order.ID != "o1" {
+		t.Fatalf("expected order o1, got %+v", first)
+	}
+	second := <-ch
+	if trade, ok := second.AsTrade(); !ok || trade.ID != "t2" || second.Channel != ChannelUser {
+		t.Fatalf("expected trade t2, got %+v", second)
+	}
+	if len(ch) != 0 {
+		t.Fatal("expected trade for other market to be filtered")
+	}
+}
+
+func TestSubscribeMarketEvents_InvalidKind(t *testing.T) {
+	c := newTestClient()
+	if _, err := c.SubscribeMarketEvents(context.Background(), []string{"tok1"}, UserOrders); err == nil {
+		t.Fatal("expected error for user kind on market stream")
+	}
+	if _, err := c.SubscribeUserEvents(context.Background(), []string{"m1"}, Orderbook); err == nil {
+		t.Fatal("expected error for market kind on user stream")
+	}
+}
+
+func TestSubscribeMarketEvents_EndToEnd(t *testing.T) {
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		var req SubscriptionRequest
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+		if req.CustomFeatureEnabled == nil || !*req.CustomFeatureEnabled {
+			t.Errorf("expected custom features for best_bid_ask")
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`[
+			{"event_type":"best_bid_ask","asset_id":"tok1","best_bid":"0.4","best_ask":"0.6"},
+			{"event_type":"last_trade_price","asset_id":"tok1","price":"0.5"}
+		]`))
+		time.Sleep(500 * time.Millisecond)
+	})
+	defer s.Close()
+
+	client, err := NewClientWithConfig("ws"+strings.TrimPrefix(s.URL, "http"), nil, &auth.APIKey{}, DefaultClientConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribeMarketEvents(context.Background(), []string{"tok1"}, BestBidAsk, LastTradePrice)
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	for _, kind := range []EventType{BestBidAsk, LastTradePrice} {
+		select {
+		case ev := <-stream.C:
+			if ev.Kind != kind {
+				t.Fatalf("expected %s, got %s", kind, ev.Kind)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", kind)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package ws
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// ChannelStats is a point-in-time snapshot of a channel's diagnostics. It is
+// JSON-encodable so it can be served directly from a health endpoint.
+type ChannelStats struct {
+	Channel             Channel             `json:"channel"`
+	State               ConnectionState     `json:"state"`
+	MessagesReceived    uint64              `json:"messages_received"`
+	MessagesByType      map[string]uint64   `json:"messages_by_type"`
+	BytesReceived       uint64              `json:"bytes_received"`
+	PongsReceived       uint64              `json:"pongs_received"`
+	LastMessageAt       time.Time           `json:"last_message_at"`
+	LastPongRTT         time.Duration       `json:"last_pong_rtt_ns"`
+	Reconnects          int                 `json:"reconnects"`
+	LastReconnectAt     time.Time           `json:"last_reconnect_at"`
+	LastReconnectReason string              `json:"last_reconnect_reason,omitempty"`
+	ActiveAssets        int                 `json:"active_assets"`
+	AssetRefs           int                 `json:"asset_refs"`
+	ActiveMarkets       int                 `json:"active_markets"`
+	MarketRefs          int                 `json:"market_refs"`
+	Subscriptions       []SubscriptionStats `json:"subscriptions"`
+}
+
+// SubscriptionStats reports per-subscription delivery counters.
+type SubscriptionStats struct {
+	ID        string    `json:"id"`
+	EventType EventType `json:"event_type"`
+	Dropped   uint64    `json:"dropped"`
+}
+
+// channelStats accumulates counters for a single channel. The zero value is ready to use.
+type channelStats struct {
+	mu            sync.Mutex
+	messages      uint64
+	byType        map[string]uint64
+	bytes         uint64
+	pongs         uint64
+	lastMessage   time.Time
+	lastPing      time.Time
+	lastRTT       time.Duration
+	reconnects    int
+	lastReconnect time.Time
+	lastReason    string
+	dropReason    string
+	pendingReason string
+}
+
+func (s *channelStats) recordFrame(size int, at time.Time) {
+	s.mu.Lock()
+	s.messages++
+	s.bytes += uint64(size)
+	s.lastMessage = at
+	s.mu.Unlock()
+}
+
+func (s *channelStats) recordEvent(eventType string) {
+	if eventType == "" {
+		eventType = "unknown"
+	}
+	s.mu.Lock()
+	if s.byType == nil {
+		s.byType = make(map[string]uint64)
+	}
+	s.byType[eventType]++
+	s.mu.Unlock()
+}
+
+func (s *channelStats) recordPing(at time.Time) {
+	s.mu.Lock()
+	s.lastPing = at
+	s.mu.Unlock()
+}
+
+func (s *channelStats) recordPong(at time.Time) {
+	s.mu.Lock()
+	s.pongs++
+	if !s.lastPing.IsZero() {
+		s.lastRTT = at.Sub(s.lastPing)
+	}
+	s.mu.Unlock()
+}
+
+// markDisconnect remembers why the client tore the connection down itself, so
+// the resulting read error is attributed to the real cause.
+func (s *channelStats) markDisconnect(reason string) {
+	s.mu.Lock()
+	s.pendingReason = reason
+	s.mu.Unlock()
+}
+
+// recordDisconnect notes why the connection dropped; the reason is reported
+// once the reconnect succeeds.
+func (s *channelStats) recordDisconnect(cause error) {
+	s.mu.Lock()
+	switch {
+	case s.pendingReason != "":
+		s.dropReason = s.pendingReason
+	case cause != nil:
+		s.dropReason = cause.Error()
+	default:
+		s.dropReason = "unknown"
+	}
+	s.pendingReason = ""
+	s.mu.Unlock()
+}
+
+// recordReconnect counts a reconnect once the new connection is dialed and
+// its subscriptions restored.
+func (s *channelStats) recordReconnect() {
+	s.mu.Lock()
+	s.reconnects++
+	s.lastReconnect = time.Now()
+	s.lastReason = s.dropReason
+	if s.lastReason == "" {
+		s.lastReason = "unknown"
+	}
+	s.dropReason = ""
+	s.mu.Unlock()
+}
+
+func (s *channelStats) snapshot(out *ChannelStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out.MessagesReceived = s.messages
+	out.BytesReceived = s.bytes
+	out.PongsReceived = s.pongs
+	out.LastMessageAt = s.lastMessage
+	out.LastPongRTT = s.lastRTT
+	out.Reconnects = s.reconnects
+	out.LastReconnectAt = s.lastReconnect
+	out.LastReconnectReason = s.lastReason
+	out.MessagesByType = make(map[string]uint64, len(s.byType))
+	for k, v := range s.byType {
+		out.MessagesByType[k] = v
+	}
+}
+
+func (c *clientImpl) statsFor(channel Channel) *channelStats {
+	switch channel {
+	case ChannelMarket:
+		return &c.marketStats
+	case ChannelUser:
+		return &c.userStats
+	default:
+		return nil
+	}
+}
+
+func (c *clientImpl) Stats(channel Channel) ChannelStats {
+	out := ChannelStats{
+		Channel:        channel,
+		State:          c.ConnectionState(channel),
+		MessagesByType: map[string]uint64{},
+		Subscriptions:  []SubscriptionStats{},
+	}
+	stats := c.statsFor(channel)
+	if stats == nil {
+		return out
+	}
+	stats.snapshot(&out)
+
+	c.subMu.Lock()
+	switch channel {
+	case ChannelMarket:
+		out.ActiveAssets, out.AssetRefs = countRefs(c.marketRefs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.orderbookSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.priceSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.midpointSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.lastTradeSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.tickSizeSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.bestBidAskSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.newMarketSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.marketResolvedSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.marketEventSubs)
+	case ChannelUser:
+		out.ActiveMarkets, out.MarketRefs = countRefs(c.userRefs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.orderSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.tradeSubs)
+		out.Subscriptions = appendSubStats(out.Subscriptions, c.userEventSubs)
+	}
+	c.subMu.Unlock()
+
+	sort.Slice(out.Subscriptions, func(i, j int) bool {
+		return out.Subscriptions[i].ID < out.Subscriptions[j].ID
+	})
+	return out
+}
+
+func countRefs(refs map[string]int) (active int, total int) {
+	for _, count := range refs {
+		if count > 0 {
+			active++
+			total += count
+		}
+	}
+	return active, total
+}
+
+func appendSubStats[T any](out []SubscriptionStats, subs map[string]*subscriptionEntry[T]) []SubscriptionStats {
+	for _, sub := range subs {
+		out = append(out, SubscriptionStats{
+			ID:        sub.id,
+			EventType: sub.event,
+			Dropped:   sub.dropped.Load(),
+		})
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStats_CountsEventsAndDrops(t *testing.T) {
+	c := newTestClient()
+	c.marketRefs["tok1"] = 2
+	c.marketRefs["tok2"] = 1
+	c.orderbookSubs["1"] = &subscriptionEntry[OrderbookEvent]{
+		id: "1", event: Orderbook, ch: make(chan OrderbookEvent, 1), errCh: make(chan error, 5),
+		assets: makeIDSet([]string{"tok1"}),
+	}
+
+	now := time.Now()
+	c.marketStats.recordFrame(120, now)
+	c.processEventAt(ChannelMarket, map[string]interface{}{"event_type": "book", "asset_id": "tok1"}, now)
+	c.processEventAt(ChannelMarket, map[string]interface{}{"event_type": "book", "asset_id": "tok1"}, now)
+	c.processEventAt(ChannelMarket, map[string]interface{}{"event_type": "tick_size_change", "asset_id": "tok2"}, now)
+
+	stats := c.Stats(ChannelMarket)
+	if stats.MessagesReceived != 1 || stats.BytesReceived != 120 {
+		t.Fatalf("unexpected frame counters: %+v", stats)
+	}
+	if !stats.LastMessageAt.Equal(now) {
+		t.Fatalf("expected last message %v, got %v", now, stats.LastMessageAt)
+	}
+	if stats.MessagesByType["book"] != 2 || stats.MessagesByType["tick_size_change"] != 1 {
+		t.Fatalf("unexpected per-type counts: %+v", stats.MessagesByType)
+	}
+	if stats.ActiveAssets != 2 || stats.AssetRefs != 3 {
+		t.Fatalf("expected 2 assets / 3 refs, got %d / %d", stats.ActiveAssets, stats.AssetRefs)
+	}
+	if len(stats.Subscriptions) != 1 || stats.Subscriptions[0].Dropped != 1 {
+		t.Fatalf("expected one dropped book event, got %+v", stats.Subscriptions)
+	}
+
+	if user := c.Stats(ChannelUser); user.MessagesReceived != 0 || len(user.MessagesByType) != 0 {
+		t.Fatalf("market traffic leaked into user stats: %+v", user)
+	}
+}
+
+func TestStats_PongAndReconnect(t *testing.T) {
+	c := newTestClient()
+	stats := c.statsFor(ChannelUser)
+
+	ping := time.Now()
+	stats.recordPing(ping)
+	stats.recordPong(ping.Add(25 * time.Millisecond))
+	stats.recordDisconnect(errors.New("use of closed network connection"))
+	stats.recordReconnect()
+	stats.recordDisconnect(errors.New("EOF"))
+	if n := c.Stats(ChannelUser).Reconnects; n != 1 {
+		t.Fatalf("expected a drop without a successful reconnect to be uncounted, got %d", n)
+	}
+	stats.recordReconnect()
+
+	snap := c.Stats(ChannelUser)
+	if snap.PongsReceived != 1 || snap.LastPongRTT != 25*time.Millisecond {
+		t.Fatalf("unexpected pong stats: %+v", snap)
+	}
+	if snap.Reconnects != 2 || snap.LastReconnectReason != "EOF" {
+		t.Fatalf("unexpected reconnect stats: %+v", snap)
+	}
+
+	stats.markDisconnect("heartbeat timeout")
+	stats.recordDisconnect(errors.New("use of closed network connection"))
+	stats.recordReconnect()
+	if got := c.Stats(ChannelUser).LastReconnectReason; got != "heartbeat timeout" {
+		t.Fatalf("expected heartbeat timeout reason, got %q", got)
+	}
+
+	if _, err := json.Marshal(snap); err != nil {
+		t.Fatalf("stats not JSON encodable: %v", err)
+	}
+}
@@ -1,21 +1,14 @@
 package ws
 
-import "fmt"
+import (
+	"fmt"
 
-// Stream delivers messages and async errors for a subscription.
-type Stream[T any] struct {
-	C      <-chan T
-	Err    <-chan error
-	closeF func() error
-}
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
+)
 
-// Close stops the subscription and closes the stream.
-func (s *Stream[T]) Close() error {
-	if s == nil || s.closeF == nil {
-		return nil
-	}
-	return s.closeF()
-}
+// Stream delivers messages and async errors for a subscription. It is the
+// shared stream type, so the operators in package stream apply to it directly.
+type Stream[T any] = stream.Stream[T]
 
 // LaggedError indicates the subscriber missed messages due to backpressure.
 type LaggedError struct {
@@ -30,3 +23,6 @@ func (e LaggedError) Error() string {
 	}
 	return fmt.Sprintf("clobws subscription lagged, missed %d messages (channel=%s type=%s)", e.Count, e.Channel, e.EventType)
 }
+
+// LagCount returns the number of messages missed, satisfying stream.Lagged.
+func (e LaggedError) LagCount() int { return e.Count }
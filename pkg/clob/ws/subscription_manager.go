@@ -2,6 +2,7 @@ package ws
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 const (
@@ -15,11 +16,13 @@ type subscriptionEntry[T any] struct {
 	event     EventType
 	assets    map[string]struct{}
 	markets   map[string]struct{}
+	kinds     map[EventType]struct{} // multiplexed streams only; nil matches every kind
 	ch        chan T
 	errCh     chan error
 	mu        sync.RWMutex // Protects channel operations
 	closed    bool
 	closeOnce sync.Once
+	dropped   atomic.Uint64 // messages discarded because the subscriber fell behind
 }
 
 func (s *subscriptionEntry[T]) matchesAsset(assetID string) bool {
@@ -50,6 +53,14 @@ func (s *subscriptionEntry[T]) matchesMarket(market string) bool {
 	return ok
 }
 
+func (s *subscriptionEntry[T]) matchesKind(kind EventType) bool {
+	if len(s.kinds) == 0 {
+		return true
+	}
+	_, ok := s.kinds[kind]
+	return ok
+}
+
 func (s *subscriptionEntry[T]) trySend(msg T) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -62,6 +73,7 @@ func (s *subscriptionEntry[T]) trySend(msg T) {
 	case s.ch <- msg:
 		return
 	default:
+		s.dropped.Add(1)
 		s.notifyLagLocked(1)
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"strconv"
 	"sync/atomic"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 )
 
 func (c *clientImpl) SubscribeOrderbookStream(ctx context.Context, assetIDs []string) (*Stream[OrderbookEvent], error) {
@@ -273,6 +275,10 @@ func (c *clientImpl) applySubscription(req *SubscriptionRequest, defaultOp Opera
 }
 
 func subscribeMarketStream[T any](c *clientImpl, ctx context.Context, assetIDs []string, eventType EventType, custom bool, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
+	return subscribeMarketStreamKinds(c, ctx, assetIDs, eventType, custom, nil, subs)
+}
+
+func subscribeMarketStreamKinds[T any](c *clientImpl, ctx context.Context, assetIDs []string, eventType EventType, custom bool, kinds map[EventType]struct{}, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
 	if len(assetIDs) == 0 {
 		return nil, errors.New("assetIDs required")
 	}
@@ -291,23 +297,24 @@ func subscribeMarketStream[T any](c *clientImpl, ctx context.Context, assetIDs [
 	}
 
 	entry := newSubscriptionEntry[T](c, ChannelMarket, eventType, assetIDs, nil)
+	entry.kinds = kinds
 	c.subMu.Lock()
 	subs[entry.id] = entry
 	c.subMu.Unlock()
 
-	stream := &Stream[T]{
-		C:   entry.ch,
-		Err: entry.errCh,
-		closeF: func() error {
-			closeMarketStream(c, entry, assetIDs, subs)
-			return nil
-		},
-	}
-	bindContext(ctx, stream)
-	return stream, nil
+	out := stream.New(entry.ch, entry.errCh, func() error {
+		closeMarketStream(c, entry, assetIDs, subs)
+		return nil
+	})
+	bindContext(ctx, out)
+	return out, nil
 }
 
 func subscribeUserStream[T any](c *clientImpl, ctx context.Context, markets []string, eventType EventType, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
+	return subscribeUserStreamKinds(c, ctx, markets, eventType, nil, subs)
+}
+
+func subscribeUserStreamKinds[T any](c *clientImpl, ctx context.Context, markets []string, eventType EventType, kinds map[EventType]struct{}, subs map[string]*subscriptionEntry[T]) (*Stream[T], error) {
 	if len(markets) == 0 {
 		return nil, errors.New("markets required")
 	}
@@ -328,20 +335,17 @@ func subscribeUserStream[T any](c *clientImpl, ctx context.Context, markets []st
 	}
 
 	entry := newSubscriptionEntry[T](c, ChannelUser, eventType, nil, markets)
+	entry.kinds = kinds
 	c.subMu.Lock()
 	subs[entry.id] = entry
 	c.subMu.Unlock()
 
-	stream := &Stream[T]{
-		C:   entry.ch,
-		Err: entry.errCh,
-		closeF: func() error {
-			closeUserStream(c, entry, markets, subs)
-			return nil
-		},
-	}
-	bindContext(ctx, stream)
-	return stream, nil
+	out := stream.New(entry.ch, entry.errCh, func() error {
+		closeUserStream(c, entry, markets, subs)
+		return nil
+	})
+	bindContext(ctx, out)
+	return out, nil
 }
 
 func bindContext[T any](ctx context.Context, stream *Stream[T]) {
@@ -420,16 +424,17 @@ func closeUserStream[T any](c *clientImpl, entry *subscriptionEntry[T], markets
 }
 
 func (c *clientImpl) authPayload() *AuthPayload {
-	if c.apiKey == nil {
+	_, apiKey := c.credentials()
+	if apiKey == nil {
 		return nil
 	}
-	if c.apiKey.Key == "" || c.apiKey.Secret == "" || c.apiKey.Passphrase == "" {
+	if apiKey.Key == "" || apiKey.Secret == "" || apiKey.Passphrase == "" {
 		return nil
 	}
 	return &AuthPayload{
-		APIKey:     c.apiKey.Key,
-		Secret:     c.apiKey.Secret,
-		Passphrase: c.apiKey.Passphrase,
+		APIKey:     apiKey.Key,
+		Secret:     apiKey.Secret,
+		Passphrase: apiKey.Passphrase,
 	}
 }
 
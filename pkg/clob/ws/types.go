@@ -1,5 +1,7 @@
 package ws
 
+import "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+
 // Event types.
 
 type EventType string
@@ -17,6 +19,8 @@ const (
 	MarketResolved           EventType = "market_resolved"
 	UserOrders               EventType = "orders"
 	UserTrades               EventType = "trades"
+	MarketEvents             EventType = "market_events" // multiplexed market stream
+	UserEvents               EventType = "user_events"   // multiplexed user stream
 	ConnectionStateEventType EventType = "connection_state"
 )
 
@@ -148,6 +152,12 @@ type PriceChangeEvent struct {
 	Side      string `json:"side"`
 	Size      string `json:"size"`
 	Timestamp string `json:"timestamp"` // Sometimes string in JSON
+
+	price     types.CachedDecimal
+	size      types.CachedDecimal
+	bestBid   types.CachedDecimal
+	bestAsk   types.CachedDecimal
+	timestamp cachedTime
 }
 
 type MidpointEvent struct {
@@ -171,6 +181,11 @@ type LastTradePriceEvent struct {
 	Size       string `json:"size,omitempty"`
 	FeeRateBps string `json:"fee_rate_bps,omitempty"`
 	Timestamp  string `json:"timestamp,omitempty"`
+
+	price      types.CachedDecimal
+	size       types.CachedDecimal
+	feeRateBps types.CachedDecimal
+	timestamp  cachedTime
 }
 
 type BestBidAskEvent struct {
@@ -180,6 +195,11 @@ type BestBidAskEvent struct {
 	BestAsk   string `json:"best_ask,omitempty"`
 	Spread    string `json:"spread,omitempty"`
 	Timestamp string `json:"timestamp,omitempty"`
+
+	bestBid   types.CachedDecimal
+	bestAsk   types.CachedDecimal
+	spread    types.CachedDecimal
+	timestamp cachedTime
 }
 
 type EventMessage struct {
@@ -247,4 +267,10 @@ type OrderEvent struct {
 	MakerAddress    string   `json:"maker_address"`
 	AssociateTrades []string `json:"associate_trades"`
 	EventType       string   `json:"event_type"`
+
+	price        types.CachedDecimal
+	originalSize types.CachedDecimal
+	sizeMatched  types.CachedDecimal
+	timestamp    cachedTime
+	createdAt    cachedTime
 }
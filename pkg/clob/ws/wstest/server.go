@@ -0,0 +1,490 @@
+// Package wstest provides an in-process fake of the CLOB WebSocket service for tests.
+//
+// The server speaks the market and user protocol understood by ws.Client:
+// subscribe and unsubscribe requests, the user auth payload, PING/PONG keep-alives
+// and initial_dump book snapshots. Tests script the feed with Publish, and
+// exercise reconnect and heartbeat handling with DropConnections, RejectNext and
+// SetPongDelay, so the real client code paths run instead of a mocked interface.
+package wstest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/gorilla/websocket"
+)
+
+// Request is a subscription message received by the server.
+type Request struct {
+	ws.SubscriptionRequest
+	// Endpoint is the channel of the connection the request arrived on.
+	Endpoint ws.Channel
+	Received time.Time
+}
+
+// Server is a fake CLOB WebSocket server backed by httptest.
+type Server struct {
+	// URL is the ws:// base URL, suitable for ws.NewClient.
+	URL string
+
+	srv      *httptest.Server
+	upgrader websocket.Upgrader
+
+	mu           sync.Mutex
+	conns        map[*serverConn]struct{}
+	accepted     map[ws.Channel]int
+	requests     []Request
+	books        map[string]ws.OrderbookEvent
+	creds        *auth.APIKey
+	pongDelay    time.Duration
+	pongDisabled bool
+	rejectNext   int
+	closed       bool
+	changed      chan struct{}
+	done         chan struct{}
+}
+
+type serverConn struct {
+	channel ws.Channel
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	assets  map[string]struct{}
+	markets map[string]struct{}
+}
+
+func (c *serverConn) write(payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials makes the server reject user subscriptions whose auth payload
+// does not match apiKey. Without it any non-empty auth payload is accepted.
+func WithCredentials(apiKey *auth.APIKey) Option {
+	return func(s *Server) {
+		s.creds = apiKey
+	}
+}
+
+// NewServer starts a fake CLOB WebSocket server. The caller must Close it.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		upgrader: websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }},
+		conns:    make(map[*serverConn]struct{}),
+		accepted: make(map[ws.Channel]int),
+		books:    make(map[string]ws.OrderbookEvent),
+		changed:  make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = "ws" + strings.TrimPrefix(s.srv.URL, "http")
+	return s
+}
+
+// Close drops every connection and shuts the server down.
+func (s *Server) Close() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+	s.mu.Unlock()
+	s.DropConnections(ws.ChannelMarket)
+	s.DropConnections(ws.ChannelUser)
+	s.srv.Close()
+}
+
+// SetCredentials replaces the credentials user subscriptions must carry, as a
+// key rotation on the server side would.
+func (s *Server) SetCredentials(apiKey *auth.APIKey) {
+	s.mu.Lock()
+	s.creds = apiKey
+	s.mu.Unlock()
+}
+
+// SetInitialBook registers the snapshot sent for the book's asset when a market
+// subscription requests initial_dump.
+func (s *Server) SetInitialBook(book ws.OrderbookEvent) {
+	s.mu.Lock()
+	s.books[book.AssetID] = book
+	s.mu.Unlock()
+}
+
+// SetPongDelay delays every PONG reply by d.
+func (s *Server) SetPongDelay(d time.Duration) {
+	s.mu.Lock()
+	s.pongDelay = d
+	s.mu.Unlock()
+}
+
+// SetPongsEnabled controls whether PING messages are answered at all.
+func (s *Server) SetPongsEnabled(enabled bool) {
+	s.mu.Lock()
+	s.pongDisabled = !enabled
+	s.mu.Unlock()
+}
+
+// RejectNext makes the next n connection attempts fail with 503 Service Unavailable.
+func (s *Server) RejectNext(n int) {
+	s.mu.Lock()
+	s.rejectNext = n
+	s.mu.Unlock()
+}
+
+// DropConnections abruptly closes every connection on channel without a close
+// frame, as a network failure would.
+func (s *Server) DropConnections(channel ws.Channel) int {
+	s.mu.Lock()
+	var dropped []*serverConn
+	for conn := range s.conns {
+		if conn.channel == channel {
+			dropped = append(dropped, conn)
+		}
+	}
+	s.mu.Unlock()
+	for _, conn := range dropped {
+		_ = conn.conn.UnderlyingConn().Close()
+	}
+	return len(dropped)
+}
+
+// ConnectionCount returns the number of open connections on channel.
+func (s *Server) ConnectionCount(channel ws.Channel) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for conn := range s.conns {
+		if conn.channel == channel {
+			count++
+		}
+	}
+	return count
+}
+
+// AcceptedCount returns the number of connections ever accepted on channel.
+func (s *Server) AcceptedCount(channel ws.Channel) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accepted[channel]
+}
+
+// Requests returns every subscription message received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Request, len(s.requests))
+	copy(out, s.requests)
+	return out
+}
+
+// Publish sends an event of the given type to subscribed connections on channel.
+// payload is encoded as JSON and event_type is added to it. Market events are
+// routed by asset_id or assets_ids and user events by market; events without
+// those keys go to every connection on the channel. It returns the number of
+// connections written to.
+func (s *Server) Publish(channel ws.Channel, eventType string, payload interface{}) (int, error) {
+	fields, err := toFields(payload)
+	if err != nil {
+		return 0, err
+	}
+	fields["event_type"] = eventType
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	targets := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		if conn.channel == channel && routes(conn, fields) {
+			targets = append(targets, conn)
+		}
+	}
+	s.mu.Unlock()
+	sent := 0
+	for _, conn := range targets {
+		if err := conn.write(raw); err == nil {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// PublishRaw writes a raw frame to every connection on channel, regardless of
+// subscriptions. Use it for batched arrays or malformed payloads.
+func (s *Server) PublishRaw(channel ws.Channel, frame []byte) int {
+	sent := 0
+	for _, conn := range s.connsFor(channel) {
+		if err := conn.write(frame); err == nil {
+			sent++
+		}
+	}
+	return sent
+}
+
+// WaitForConnections blocks until at least n connections have been accepted on channel.
+func (s *Server) WaitForConnections(ctx context.Context, channel ws.Channel, n int) error {
+	return s.waitFor(ctx, func() bool { return s.accepted[channel] >= n })
+}
+
+// WaitForSubscription blocks until an open connection on channel is subscribed
+// to every id (asset IDs on the market channel, markets on the user channel).
+func (s *Server) WaitForSubscription(ctx context.Context, channel ws.Channel, ids ...string) error {
+	return s.waitFor(ctx, func() bool {
+		for conn := range s.conns {
+			if conn.channel != channel {
+				continue
+			}
+			set := conn.assets
+			if channel == ws.ChannelUser {
+				set = conn.markets
+			}
+			all := true
+			for _, id := range ids {
+				if _, ok := set[id]; !ok {
+					all = false
+					break
+				}
+			}
+			if all {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (s *Server) waitFor(ctx context.Context, cond func() bool) error {
+	for {
+		s.mu.Lock()
+		ok := cond()
+		changed := s.changed
+		s.mu.Unlock()
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// notifyLocked wakes waiters. Caller must hold s.mu.
+func (s *Server) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *Server) connsFor(channel ws.Channel) []*serverConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		if conn.channel == channel {
+			out = append(out, conn)
+		}
+	}
+	return out
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	var channel ws.Channel
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/ws/market"):
+		channel = ws.ChannelMarket
+	case strings.HasSuffix(r.URL.Path, "/ws/user"):
+		channel = ws.ChannelUser
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	if s.closed || s.rejectNext > 0 {
+		if s.rejectNext > 0 {
+			s.rejectNext--
+		}
+		s.mu.Unlock()
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	s.mu.Unlock()
+
+	raw, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &serverConn{
+		channel: channel,
+		conn:    raw,
+		assets:  make(map[string]struct{}),
+		markets: make(map[string]struct{}),
+	}
+
+	s.mu.Lock()
+	s.conns[conn] = struct{}{}
+	s.accepted[channel]++
+	s.notifyLocked()
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.notifyLocked()
+		s.mu.Unlock()
+		_ = raw.Close()
+	}()
+
+	for {
+		_, message, err := raw.ReadMessage()
+		if err != nil {
+			return
+		}
+		if string(message) == "PING" {
+			s.pong(conn)
+			continue
+		}
+		var req ws.SubscriptionRequest
+		if err := json.Unmarshal(message, &req); err != nil {
+			continue
+		}
+		if err := s.apply(conn, req); err != nil {
+			_ = raw.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()),
+				time.Now().Add(time.Second))
+			return
+		}
+	}
+}
+
+func (s *Server) pong(conn *serverConn) {
+	s.mu.Lock()
+	disabled := s.pongDisabled
+	delay := s.pongDelay
+	s.mu.Unlock()
+	if disabled {
+		return
+	}
+	if delay <= 0 {
+		_ = conn.write([]byte("PONG"))
+		return
+	}
+	go func() {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-s.done:
+		case <-timer.C:
+			_ = conn.write([]byte("PONG"))
+		}
+	}()
+}
+
+func (s *Server) apply(conn *serverConn, req ws.SubscriptionRequest) error {
+	if conn.channel == ws.ChannelUser && !s.authorized(req.Auth) {
+		return errors.New("invalid auth payload")
+	}
+
+	unsubscribe := req.Operation == ws.OperationUnsubscribe
+	var dump []ws.OrderbookEvent
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{SubscriptionRequest: req, Endpoint: conn.channel, Received: time.Now()})
+	ids, set := req.AssetIDs, conn.assets
+	if conn.channel == ws.ChannelUser {
+		ids, set = req.Markets, conn.markets
+	}
+	for _, id := range ids {
+		if unsubscribe {
+			delete(set, id)
+			continue
+		}
+		set[id] = struct{}{}
+		if conn.channel == ws.ChannelMarket && req.InitialDump != nil && *req.InitialDump {
+			if book, ok := s.books[id]; ok {
+				dump = append(dump, book)
+			}
+		}
+	}
+	s.notifyLocked()
+	s.mu.Unlock()
+
+	for _, book := range dump {
+		fields, err := toFields(book)
+		if err != nil {
+			continue
+		}
+		fields["event_type"] = "book"
+		if raw, err := json.Marshal(fields); err == nil {
+			_ = conn.write(raw)
+		}
+	}
+	return nil
+}
+
+func (s *Server) authorized(payload *ws.AuthPayload) bool {
+	if payload == nil || payload.APIKey == "" || payload.Secret == "" || payload.Passphrase == "" {
+		return false
+	}
+	s.mu.Lock()
+	creds := s.creds
+	s.mu.Unlock()
+	if creds == nil {
+		return true
+	}
+	return payload.APIKey == creds.Key && payload.Secret == creds.Secret && payload.Passphrase == creds.Passphrase
+}
+
+// routes reports whether conn is subscribed to the event. Caller must hold s.mu.
+func routes(conn *serverConn, fields map[string]interface{}) bool {
+	if conn.channel == ws.ChannelUser {
+		market, _ := fields["market"].(string)
+		if market == "" {
+			return true
+		}
+		_, ok := conn.markets[market]
+		return ok
+	}
+	if asset, ok := fields["asset_id"].(string); ok && asset != "" {
+		_, ok := conn.assets[asset]
+		return ok
+	}
+	if list, ok := fields["assets_ids"].([]interface{}); ok && len(list) > 0 {
+		for _, item := range list {
+			if id, ok := item.(string); ok {
+				if _, ok := conn.assets[id]; ok {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func toFields(payload interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
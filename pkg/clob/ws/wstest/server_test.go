@@ -0,0 +1,335 @@
+package wstest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws/wstest"
+)
+
+func testConfig() ws.ClientConfig {
+	cfg := ws.DefaultClientConfig()
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	cfg.ReconnectMaxDelay = 20 * time.Millisecond
+	cfg.HeartbeatInterval = 20 * time.Millisecond
+	cfg.HeartbeatTimeout = time.Second
+	return cfg
+}
+
+func waitCtx(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func TestServer_InitialDumpAndPublish(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+	srv.SetInitialBook(ws.OrderbookEvent{
+		AssetID: "tok1",
+		Bids:    []ws.OrderbookLevel{{Price: "0.4", Size: "10"}},
+		Asks:    []ws.OrderbookLevel{{Price: "0.6", Size: "10"}},
+	})
+
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, testConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribeMarketEvents(context.Background(), []string{"tok1"}, ws.Orderbook, ws.LastTradePrice)
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	select {
+	case ev := <-stream.C:
+		book, ok := ev.AsOrderbook()
+		if !ok || book.Bids[0].PriceDec().String() != "0.4" {
+			t.Fatalf("expected initial book, got %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for initial dump")
+	}
+
+	sent, err := srv.Publish(ws.ChannelMarket, "last_trade_price", ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.55"})
+	if err != nil || sent != 1 {
+		t.Fatalf("publish sent=%d err=%v", sent, err)
+	}
+	if sent, _ := srv.Publish(ws.ChannelMarket, "last_trade_price", ws.LastTradePriceEvent{AssetID: "other", Price: "0.1"}); sent != 0 {
+		t.Fatalf("expected unsubscribed asset to be skipped, sent=%d", sent)
+	}
+	select {
+	case ev := <-stream.C:
+		if trade, ok := ev.AsLastTradePrice(); !ok || trade.PriceDec().String() != "0.55" {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for published event")
+	}
+}
+
+func TestServer_DropTriggersReconnectAndResubscribe(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, testConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribePricesStream(context.Background(), []string{"tok1", "tok2"})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+	ctx := waitCtx(t)
+	if err := srv.WaitForSubscription(ctx, ws.ChannelMarket, "tok1", "tok2"); err != nil {
+		t.Fatalf("initial subscription: %v", err)
+	}
+
+	srv.DropConnections(ws.ChannelMarket)
+	if err := srv.WaitForConnections(ctx, ws.ChannelMarket, 2); err != nil {
+		t.Fatalf("client did not reconnect: %v", err)
+	}
+	if err := srv.WaitForSubscription(ctx, ws.ChannelMarket, "tok1", "tok2"); err != nil {
+		t.Fatalf("client did not resubscribe: %v", err)
+	}
+	if got := len(srv.Requests()); got != 2 {
+		t.Fatalf("expected subscribe and resubscribe requests, got %d", got)
+	}
+	deadline := time.Now().Add(time.Second)
+	for client.Stats(ws.ChannelMarket).Reconnects != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected one reconnect, got %+v", client.Stats(ws.ChannelMarket))
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestServer_ReconnectMaxGivesUp(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+
+	cfg := testConfig()
+	cfg.ReconnectMax = 2
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, cfg)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	states, err := client.ConnectionStateStream(context.Background())
+	if err != nil {
+		t.Fatalf("state stream failed: %v", err)
+	}
+	defer states.Close()
+
+	srv.RejectNext(100)
+	srv.DropConnections(ws.ChannelMarket)
+
+	attempts := 0
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case ev := <-states.C:
+			if ev.Channel != ws.ChannelMarket {
+				continue
+			}
+			if ev.State == ws.ConnectionReconnecting {
+				attempts = ev.Attempt
+			}
+			if ev.State == ws.ConnectionDisconnected && attempts > 0 {
+				if attempts != cfg.ReconnectMax {
+					t.Fatalf("expected %d attempts, got %d", cfg.ReconnectMax, attempts)
+				}
+				if n := client.Stats(ws.ChannelMarket).Reconnects; n != 0 {
+					t.Fatalf("failed reconnect attempts should not be counted, got %d", n)
+				}
+				return
+			}
+		case <-deadline:
+			t.Fatalf("client did not give up (attempts=%d)", attempts)
+		}
+	}
+}
+
+func TestServer_HeartbeatTimeoutReconnects(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+	srv.SetPongsEnabled(false)
+
+	cfg := testConfig()
+	cfg.HeartbeatTimeout = 60 * time.Millisecond
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, cfg)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	if err := srv.WaitForConnections(waitCtx(t), ws.ChannelMarket, 2); err != nil {
+		t.Fatalf("expected heartbeat timeout to force a reconnect: %v", err)
+	}
+}
+
+func TestServer_DelayedPongsWithinTimeout(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+	srv.SetPongDelay(10 * time.Millisecond)
+
+	cfg := testConfig()
+	cfg.HeartbeatTimeout = 200 * time.Millisecond
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, cfg)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	time.Sleep(300 * time.Millisecond)
+	if got := srv.AcceptedCount(ws.ChannelMarket); got != 1 {
+		t.Fatalf("expected a single stable connection, got %d", got)
+	}
+}
+
+func TestServer_LagReported(t *testing.T) {
+	srv := wstest.NewServer()
+	defer srv.Close()
+
+	client, err := ws.NewClientWithConfig(srv.URL, nil, nil, testConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribeLastTradePricesStream(context.Background(), []string{"tok1"})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+	if err := srv.WaitForSubscription(waitCtx(t), ws.ChannelMarket, "tok1"); err != nil {
+		t.Fatalf("subscription: %v", err)
+	}
+
+	for i := 0; i < 150; i++ {
+		if _, err := srv.Publish(ws.ChannelMarket, "last_trade_price", ws.LastTradePriceEvent{AssetID: "tok1", Price: "0.5"}); err != nil {
+			t.Fatalf("publish failed: %v", err)
+		}
+	}
+
+	select {
+	case err := <-stream.Err:
+		var lagged ws.LaggedError
+		if !errors.As(err, &lagged) {
+			t.Fatalf("expected LaggedError, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected lag to be reported")
+	}
+}
+
+func TestServer_UserAuth(t *testing.T) {
+	creds := &auth.APIKey{Key: "key", Secret: "secret", Passphrase: "pass"}
+	srv := wstest.NewServer(wstest.WithCredentials(creds))
+	defer srv.Close()
+
+	client, err := ws.NewClientWithConfig(srv.URL, nil, creds, testConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribeUserEvents(context.Background(), []string{"m1"})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+	if err := srv.WaitForSubscription(waitCtx(t), ws.ChannelUser, "m1"); err != nil {
+		t.Fatalf("subscription: %v", err)
+	}
+	req := srv.Requests()[0]
+	if req.Endpoint != ws.ChannelUser || req.Auth == nil || req.Auth.APIKey != "key" {
+		t.Fatalf("unexpected request %+v", req)
+	}
+
+	if _, err := srv.Publish(ws.ChannelUser, "order", ws.OrderEvent{ID: "o1", Market: "m1"}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	select {
+	case ev := <-stream.C:
+		if order, ok := ev.AsOrder(); !ok || order.ID != "o1" {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for order")
+	}
+}
+
+func TestServer_UserResubscribesWithRotatedKey(t *testing.T) {
+	oldKey := &auth.APIKey{Key: "old", Secret: "secret", Passphrase: "pass"}
+	newKey := &auth.APIKey{Key: "new", Secret: "secret2", Passphrase: "pass2"}
+	srv := wstest.NewServer(wstest.WithCredentials(oldKey))
+	defer srv.Close()
+
+	client, err := ws.NewClientWithConfig(srv.URL, nil, oldKey, testConfig())
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	stream, err := client.SubscribeUserOrdersStream(context.Background(), []string{"m1"})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+	ctx := waitCtx(t)
+	if err := srv.WaitForSubscription(ctx, ws.ChannelUser, "m1"); err != nil {
+		t.Fatalf("initial subscription: %v", err)
+	}
+
+	client.Authenticate(nil, newKey)
+	srv.SetCredentials(newKey)
+	srv.DropConnections(ws.ChannelUser)
+	if err := srv.WaitForConnections(ctx, ws.ChannelUser, 2); err != nil {
+		t.Fatalf("client did not reconnect: %v", err)
+	}
+	if err := srv.WaitForSubscription(ctx, ws.ChannelUser, "m1"); err != nil {
+		t.Fatalf("client did not resubscribe after rotation: %v", err)
+	}
+	reqs := srv.Requests()
+	last := reqs[len(reqs)-1]
+	if last.Endpoint != ws.ChannelUser || last.Auth == nil || last.Auth.APIKey != "new" {
+		t.Fatalf("expected resubscribe with rotated key, got %+v", last)
+	}
+}
+
+func TestServer_UserAuthRejected(t *testing.T) {
+	srv := wstest.NewServer(wstest.WithCredentials(&auth.APIKey{Key: "key", Secret: "secret", Passphrase: "pass"}))
+	defer srv.Close()
+
+	cfg := testConfig()
+	cfg.Reconnect = false
+	wrong := &auth.APIKey{Key: "key", Secret: "wrong", Passphrase: "pass"}
+	client, err := ws.NewClientWithConfig(srv.URL, nil, wrong, cfg)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+
+	if _, err := client.SubscribeUserOrdersStream(context.Background(), []string{"m1"}); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for client.ConnectionState(ws.ChannelUser) != ws.ConnectionDisconnected {
+		if time.Now().After(deadline) {
+			t.Fatal("expected user connection to be closed for invalid credentials")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,597 @@
+// Package comments maintains a live, threaded view of the comments on a
+// Polymarket event or market by combining gamma history with RTDS updates.
+package comments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/gamma"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+const defaultPageSize = 100
+
+var (
+	ErrMissingEntity  = errors.New("comments: entity type and id are required")
+	ErrAlreadyStarted = errors.New("comments: thread already started")
+)
+
+// HistorySource pages through stored comments. gamma.Client satisfies it.
+type HistorySource interface {
+	Comments(ctx context.Context, req *gamma.CommentsRequest) ([]gamma.Comment, error)
+}
+
+// LiveSource streams comment events. rtds.Client satisfies it.
+type LiveSource interface {
+	SubscribeRawStream(ctx context.Context, sub *rtds.Subscription) (*rtds.Stream[rtds.RtdsMessage], error)
+}
+
+// Profile describes a comment author.
+type Profile struct {
+	Name                  string
+	Pseudonym             string
+	DisplayUsernamePublic bool
+	ProxyWallet           string
+	BaseAddress           string
+	ProfileImage          string
+}
+
+// Comment is a single node of the thread.
+type Comment struct {
+	ID            string
+	ParentID      string
+	Body          string
+	UserAddress   string
+	ReplyAddress  string
+	Profile       Profile
+	CreatedAt     time.Time
+	ReactionCount int
+	// Removed marks a deleted comment kept as a tombstone because it still has replies.
+	Removed bool
+}
+
+// Entry is a comment together with its depth in the thread; roots have depth 0.
+type Entry struct {
+	Comment
+	Depth int
+}
+
+// ChangeKind describes how the thread changed.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeReaction ChangeKind = "reaction"
+)
+
+// Change is reported to Config.OnChange after the thread has been updated.
+type Change struct {
+	Kind    ChangeKind
+	Comment Comment
+	// Live is true when the change came from RTDS rather than the gamma backfill.
+	Live bool
+}
+
+// Config selects the entity to follow and controls backfill.
+type Config struct {
+	// EntityType is the gamma parent entity type, such as "Event" or "Series".
+	EntityType string
+	EntityID   string
+	// PageSize is the gamma page size. Defaults to 100.
+	PageSize int
+	// MaxPages bounds the backfill; zero loads all history.
+	MaxPages int
+	// NewestFirst orders root comments newest first. Replies are always chronological.
+	NewestFirst bool
+	// HoldersOnly restricts the backfill to comments from position holders.
+	HoldersOnly bool
+	// OnChange, when set, is called synchronously for every change.
+	OnChange func(Change)
+}
+
+type node struct {
+	Comment
+	reactions map[string]struct{}
+}
+
+// CommentThread is a de-duplicated, threaded view of an entity's comments.
+// It is safe for concurrent use.
+type CommentThread struct {
+	cfg     Config
+	history HistorySource
+	live    LiveSource
+
+	mu       sync.RWMutex
+	nodes    map[string]*node
+	children map[string][]string
+	removed  map[string]struct{}
+	started  bool
+	stream   *rtds.Stream[rtds.RtdsMessage]
+	done     chan struct{}
+}
+
+// NewCommentThread creates a thread for cfg.EntityType/cfg.EntityID. Either
+// source may be nil to run history-only or live-only.
+func NewCommentThread(history HistorySource, live LiveSource, cfg Config) (*CommentThread, error) {
+	if strings.TrimSpace(cfg.EntityType) == "" || strings.TrimSpace(cfg.EntityID) == "" {
+		return nil, ErrMissingEntity
+	}
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = defaultPageSize
+	}
+	return &CommentThread{
+		cfg:      cfg,
+		history:  history,
+		live:     live,
+		nodes:    make(map[string]*node),
+		children: make(map[string][]string),
+		removed:  make(map[string]struct{}),
+	}, nil
+}
+
+// Start subscribes to live updates and then backfills history. Live events
+// that arrive during the backfill are applied immediately; duplicates are merged.
+func (t *CommentThread) Start(ctx context.Context) error {
+	t.mu.Lock()
+	if t.started {
+		t.mu.Unlock()
+		return ErrAlreadyStarted
+	}
+	t.started = true
+	t.mu.Unlock()
+
+	if t.live != nil {
+		stream, err := t.live.SubscribeRawStream(ctx, &rtds.Subscription{
+			Topic:   string(rtds.Comments),
+			MsgType: "*",
+			Filters: t.liveFilters(),
+		})
+		if err != nil {
+			return fmt.Errorf("subscribe comments: %w", err)
+		}
+		t.mu.Lock()
+		t.stream = stream
+		t.done = make(chan struct{})
+		t.mu.Unlock()
+		go t.consume(stream, t.done)
+	}
+	if t.history != nil {
+		if err := t.Backfill(ctx); err != nil {
+			_ = t.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops live updates. The collected thread stays readable.
+func (t *CommentThread) Close() error {
+	t.mu.Lock()
+	stream, done := t.stream, t.done
+	t.stream = nil
+	t.mu.Unlock()
+	if stream == nil {
+		return nil
+	}
+	err := stream.Close()
+	<-done
+	return err
+}
+
+func (t *CommentThread) liveFilters() map[string]interface{} {
+	filters := map[string]interface{}{"parentEntityType": t.cfg.EntityType}
+	if id, err := strconv.ParseInt(t.cfg.EntityID, 10, 64); err == nil {
+		filters["parentEntityID"] = id
+	} else {
+		filters["parentEntityID"] = t.cfg.EntityID
+	}
+	return filters
+}
+
+func (t *CommentThread) consume(stream *rtds.Stream[rtds.RtdsMessage], done chan struct{}) {
+	defer close(done)
+	errC := stream.Err
+	for {
+		select {
+		case msg, ok := <-stream.C:
+			if !ok {
+				return
+			}
+			t.Apply(msg)
+		case _, ok := <-errC:
+			if !ok {
+				errC = nil
+			}
+		}
+	}
+}
+
+// Backfill loads history from gamma, page by page, newest first.
+func (t *CommentThread) Backfill(ctx context.Context) error {
+	if t.history == nil {
+		return nil
+	}
+	limit := t.cfg.PageSize
+	ascending := false
+	for page := 0; t.cfg.MaxPages == 0 || page < t.cfg.MaxPages; page++ {
+		offset := page * limit
+		req := &gamma.CommentsRequest{
+			ParentEntityType: t.cfg.EntityType,
+			ParentEntityID:   t.cfg.EntityID,
+			Limit:            &limit,
+			Offset:           &offset,
+			Order:            "createdAt",
+			Ascending:        &ascending,
+		}
+		if t.cfg.HoldersOnly {
+			holders := true
+			req.HoldersOnly = &holders
+		}
+		items, err := t.history.Comments(ctx, req)
+		if err != nil {
+			return fmt.Errorf("backfill comments (offset %d): %w", offset, err)
+		}
+		for _, item := range items {
+			t.upsert(fromGamma(item), false)
+		}
+		if len(items) < limit {
+			return nil
+		}
+	}
+	return nil
+}
+
+func fromGamma(c gamma.Comment) Comment {
+	out := Comment{
+		ID:            c.ID,
+		ParentID:      c.ParentCommentID,
+		Body:          c.Body,
+		UserAddress:   c.UserAddress,
+		ReplyAddress:  c.ReplyAddress,
+		CreatedAt:     parseTime(c.CreatedAt),
+		ReactionCount: c.ReactionCount,
+	}
+	if p := c.Profile; p != nil {
+		out.Profile = Profile{
+			Name:         p.Name,
+			Pseudonym:    p.Pseudonym,
+			ProxyWallet:  p.ProxyWallet,
+			BaseAddress:  p.BaseAddress,
+			ProfileImage: p.ProfileImage,
+		}
+		if p.DisplayUsernamePublic != nil {
+			out.Profile.DisplayUsernamePublic = *p.DisplayUsernamePublic
+		}
+	}
+	return out
+}
+
+func parseTime(raw string) time.Time {
+	if raw == "" {
+		return time.Time{}
+	}
+	if t, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+		return t.UTC()
+	}
+	t, _ := types.ParseTimestamp(raw)
+	return t
+}
+
+// liveComment is decoded leniently because RTDS sends some IDs as numbers.
+type liveComment struct {
+	ID               flexID      `json:"id"`
+	Body             string      `json:"body"`
+	CreatedAt        string      `json:"createdAt"`
+	ParentCommentID  flexID      `json:"parentCommentID"`
+	ParentEntityID   flexID      `json:"parentEntityID"`
+	ParentEntityType string      `json:"parentEntityType"`
+	ReactionCount    int         `json:"reactionCount"`
+	ReplyAddress     string      `json:"replyAddress"`
+	UserAddress      string      `json:"userAddress"`
+	Profile          liveProfile `json:"profile"`
+	CommentID        flexID      `json:"commentID"`
+}
+
+type liveProfile struct {
+	Name                  string `json:"name"`
+	Pseudonym             string `json:"pseudonym"`
+	DisplayUsernamePublic bool   `json:"displayUsernamePublic"`
+	ProxyWallet           string `json:"proxyWallet"`
+	BaseAddress           string `json:"baseAddress"`
+	ProfileImage          string `json:"profileImage"`
+}
+
+type flexID string
+
+func (f *flexID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = ""
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*f = flexID(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*f = flexID(n.String())
+	return nil
+}
+
+// Apply folds a raw RTDS comments message into the thread. It reports whether
+// the thread changed; messages for other entities and duplicates are ignored.
+func (t *CommentThread) Apply(msg rtds.RtdsMessage) bool {
+	if msg.Topic != string(rtds.Comments) {
+		return false
+	}
+	var payload liveComment
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return false
+	}
+	switch rtds.CommentType(msg.MsgType) {
+	case rtds.CommentCreated:
+		if !t.sameEntity(payload) {
+			return false
+		}
+		return t.upsert(Comment{
+			ID:            string(payload.ID),
+			ParentID:      string(payload.ParentCommentID),
+			Body:          payload.Body,
+			UserAddress:   payload.UserAddress,
+			ReplyAddress:  payload.ReplyAddress,
+			CreatedAt:     parseTime(payload.CreatedAt),
+			ReactionCount: payload.ReactionCount,
+			Profile:       Profile(payload.Profile),
+		}, true)
+	case rtds.CommentRemoved:
+		return t.remove(string(payload.ID))
+	case rtds.ReactionCreated:
+		return t.react(string(payload.CommentID), string(payload.ID), 1)
+	case rtds.ReactionRemoved:
+		return t.react(string(payload.CommentID), string(payload.ID), -1)
+	default:
+		return false
+	}
+}
+
+func (t *CommentThread) sameEntity(c liveComment) bool {
+	if c.ParentEntityType != "" && !strings.EqualFold(c.ParentEntityType, t.cfg.EntityType) {
+		return false
+	}
+	return c.ParentEntityID == "" || string(c.ParentEntityID) == t.cfg.EntityID
+}
+
+func (t *CommentThread) upsert(c Comment, live bool) bool {
+	if c.ID == "" {
+		return false
+	}
+	t.mu.Lock()
+	if _, gone := t.removed[c.ID]; gone {
+		t.mu.Unlock()
+		return false
+	}
+	if existing, ok := t.nodes[c.ID]; ok {
+		// Fill gaps only; the first copy seen wins so the view never flickers.
+		changed := false
+		if existing.Profile == (Profile{}) && c.Profile != (Profile{}) {
+			existing.Profile = c.Profile
+			changed = true
+		}
+		if existing.CreatedAt.IsZero() && !c.CreatedAt.IsZero() {
+			existing.CreatedAt = c.CreatedAt
+			changed = true
+		}
+		t.mu.Unlock()
+		return changed
+	}
+	n := &node{Comment: c}
+	t.nodes[c.ID] = n
+	t.children[c.ParentID] = append(t.children[c.ParentID], c.ID)
+	snapshot := n.Comment
+	t.mu.Unlock()
+
+	t.notify(Change{Kind: ChangeAdded, Comment: snapshot, Live: live})
+	return true
+}
+
+func (t *CommentThread) remove(id string) bool {
+	if id == "" {
+		return false
+	}
+	t.mu.Lock()
+	if _, gone := t.removed[id]; gone {
+		t.mu.Unlock()
+		return false
+	}
+	t.removed[id] = struct{}{}
+	n, ok := t.nodes[id]
+	if !ok {
+		t.mu.Unlock()
+		return false
+	}
+	if len(t.children[id]) > 0 {
+		n.Removed = true
+		n.Body = ""
+	} else {
+		t.detachLocked(n)
+	}
+	snapshot := n.Comment
+	snapshot.Removed = true
+	t.mu.Unlock()
+
+	t.notify(Change{Kind: ChangeRemoved, Comment: snapshot, Live: true})
+	return true
+}
+
+// detachLocked drops n and any tombstoned ancestors left without replies.
+func (t *CommentThread) detachLocked(n *node) {
+	delete(t.nodes, n.ID)
+	siblings := t.children[n.ParentID]
+	for i, id := range siblings {
+		if id == n.ID {
+			siblings = append(siblings[:i:i], siblings[i+1:]...)
+			break
+		}
+	}
+	if len(siblings) == 0 {
+		delete(t.children, n.ParentID)
+		if parent, ok := t.nodes[n.ParentID]; ok && parent.Removed {
+			t.detachLocked(parent)
+		}
+		return
+	}
+	t.children[n.ParentID] = siblings
+}
+
+func (t *CommentThread) react(commentID, reactionID string, delta int) bool {
+	if commentID == "" {
+		return false
+	}
+	t.mu.Lock()
+	n, ok := t.nodes[commentID]
+	if !ok {
+		t.mu.Unlock()
+		return false
+	}
+	if reactionID != "" {
+		_, seen := n.reactions[reactionID]
+		switch {
+		case delta > 0 && seen:
+			t.mu.Unlock()
+			return false
+		case delta > 0:
+			if n.reactions == nil {
+				n.reactions = make(map[string]struct{})
+			}
+			n.reactions[reactionID] = struct{}{}
+		case seen:
+			delete(n.reactions, reactionID)
+		case n.ReactionCount <= len(n.reactions):
+			// Unknown reaction and no backfilled count left to account for it.
+			t.mu.Unlock()
+			return false
+		}
+	}
+	n.ReactionCount += delta
+	if n.ReactionCount < 0 {
+		n.ReactionCount = 0
+	}
+	snapshot := n.Comment
+	t.mu.Unlock()
+
+	t.notify(Change{Kind: ChangeReaction, Comment: snapshot, Live: true})
+	return true
+}
+
+func (t *CommentThread) notify(change Change) {
+	if t.cfg.OnChange != nil {
+		t.cfg.OnChange(change)
+	}
+}
+
+// Len returns the number of comments held, including tombstones.
+func (t *CommentThread) Len() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.nodes)
+}
+
+// Get returns a comment by ID.
+func (t *CommentThread) Get(id string) (Comment, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	n, ok := t.nodes[id]
+	if !ok {
+		return Comment{}, false
+	}
+	return n.Comment, true
+}
+
+// Replies returns the direct replies to id in chronological order.
+func (t *CommentThread) Replies(id string) []Comment {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	ids := t.sortedLocked(t.children[id], false)
+	out := make([]Comment, 0, len(ids))
+	for _, childID := range ids {
+		out = append(out, t.nodes[childID].Comment)
+	}
+	return out
+}
+
+// Snapshot returns the whole thread in display order: each root followed by
+// its replies, depth first. Replies whose parent is unknown are shown as roots.
+func (t *CommentThread) Snapshot() []Entry {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	out := make([]Entry, 0, len(t.nodes))
+	var walk func(id string, depth int)
+	walk = func(id string, depth int) {
+		out = append(out, Entry{Comment: t.nodes[id].Comment, Depth: depth})
+		for _, child := range t.sortedLocked(t.children[id], false) {
+			walk(child, depth+1)
+		}
+	}
+	for _, id := range t.sortedLocked(t.rootsLocked(), t.cfg.NewestFirst) {
+		walk(id, 0)
+	}
+	return out
+}
+
+// All iterates over a consistent snapshot of the thread in display order.
+func (t *CommentThread) All() iter.Seq[Entry] {
+	entries := t.Snapshot()
+	return func(yield func(Entry) bool) {
+		for _, entry := range entries {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+func (t *CommentThread) rootsLocked() []string {
+	var roots []string
+	for id, n := range t.nodes {
+		if n.ParentID == "" {
+			roots = append(roots, id)
+			continue
+		}
+		if _, ok := t.nodes[n.ParentID]; !ok {
+			roots = append(roots, id)
+		}
+	}
+	return roots
+}
+
+func (t *CommentThread) sortedLocked(ids []string, newestFirst bool) []string {
+	out := append([]string(nil), ids...)
+	sort.Slice(out, func(i, j int) bool {
+		a, b := t.nodes[out[i]], t.nodes[out[j]]
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			if newestFirst {
+				return a.CreatedAt.After(b.CreatedAt)
+			}
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		if len(a.ID) != len(b.ID) {
+			return (len(a.ID) < len(b.ID)) != newestFirst
+		}
+		return (a.ID < b.ID) != newestFirst
+	})
+	return out
+}
@@ -0,0 +1,250 @@
+package comments
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/gamma"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+)
+
+type fakeHistory struct {
+	pages [][]gamma.Comment
+	reqs  []gamma.CommentsRequest
+	err   error
+}
+
+func (f *fakeHistory) Comments(ctx context.Context, req *gamma.CommentsRequest) ([]gamma.Comment, error) {
+	f.reqs = append(f.reqs, *req)
+	if f.err != nil {
+		return nil, f.err
+	}
+	page := *req.Offset / *req.Limit
+	if page >= len(f.pages) {
+		return nil, nil
+	}
+	return f.pages[page], nil
+}
+
+type fakeLive struct {
+	ch  chan rtds.RtdsMessage
+	sub *rtds.Subscription
+}
+
+func (f *fakeLive) SubscribeRawStream(ctx context.Context, sub *rtds.Subscription) (*rtds.Stream[rtds.RtdsMessage], error) {
+	f.sub = sub
+	return &rtds.Stream[rtds.RtdsMessage]{C: f.ch, Err: make(chan error)}, nil
+}
+
+func msg(t *testing.T, kind rtds.CommentType, payload map[string]interface{}) rtds.RtdsMessage {
+	t.Helper()
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rtds.RtdsMessage{Topic: string(rtds.Comments), MsgType: string(kind), Payload: raw}
+}
+
+func ts(min int) string {
+	return time.Date(2026, 3, 1, 12, min, 0, 0, time.UTC).Format(time.RFC3339)
+}
+
+func ids(entries []Entry) []string {
+	out := make([]string, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, e.ID)
+	}
+	return out
+}
+
+func TestNewCommentThreadRequiresEntity(t *testing.T) {
+	if _, err := NewCommentThread(nil, nil, Config{EntityType: "Event"}); !errors.Is(err, ErrMissingEntity) {
+		t.Fatalf("expected ErrMissingEntity, got %v", err)
+	}
+}
+
+func TestCommentThread_BackfillPaginatesAndThreads(t *testing.T) {
+	history := &fakeHistory{pages: [][]gamma.Comment{
+		{
+			{ID: "3", ParentCommentID: "1", Body: "reply", CreatedAt: ts(3)},
+			{ID: "2", Body: "second root", CreatedAt: ts(2), Profile: &gamma.CommentProfile{Name: "bob"}},
+		},
+		{
+			{ID: "1", Body: "first root", CreatedAt: ts(1), ReactionCount: 2},
+			{ID: "4", ParentCommentID: "3", Body: "nested", CreatedAt: ts(4)},
+		},
+		{
+			{ID: "2", Body: "duplicate across pages", CreatedAt: ts(2)},
+		},
+	}}
+	thread, err := NewCommentThread(history, nil, Config{EntityType: "Event", EntityID: "42", PageSize: 2})
+	if err != nil {
+		t.Fatalf("NewCommentThread failed: %v", err)
+	}
+	if err := thread.Start(context.Background()); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+
+	if len(history.reqs) != 3 || *history.reqs[2].Offset != 4 || history.reqs[0].ParentEntityID != "42" {
+		t.Fatalf("unexpected requests: %+v", history.reqs)
+	}
+	if thread.Len() != 4 {
+		t.Fatalf("expected 4 comments, got %d", thread.Len())
+	}
+	snap := thread.Snapshot()
+	if got := ids(snap); len(got) != 4 || got[0] != "1" || got[1] != "3" || got[2] != "4" || got[3] != "2" {
+		t.Fatalf("unexpected order %v", got)
+	}
+	if snap[2].Depth != 2 || snap[3].Depth != 0 {
+		t.Fatalf("unexpected depths: %+v", snap)
+	}
+	if c, _ := thread.Get("2"); c.Body != "second root" || c.Profile.Name != "bob" {
+		t.Fatalf("duplicate overwrote original: %+v", c)
+	}
+}
+
+func TestCommentThread_LiveUpdates(t *testing.T) {
+	live := &fakeLive{ch: make(chan rtds.RtdsMessage, 10)}
+	history := &fakeHistory{pages: [][]gamma.Comment{{{ID: "1", Body: "root", CreatedAt: ts(1), ReactionCount: 1}}}}
+	var changes []Change
+	changed := make(chan struct{}, 20)
+	thread, _ := NewCommentThread(history, live, Config{
+		EntityType:  "Event",
+		EntityID:    "42",
+		NewestFirst: true,
+		OnChange: func(c Change) {
+			changes = append(changes, c)
+			changed <- struct{}{}
+		},
+	})
+	if err := thread.Start(context.Background()); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	<-changed // backfilled root
+
+	raw, _ := json.Marshal(live.sub)
+	if string(raw) != `{"filters":{"parentEntityID":42,"parentEntityType":"Event"},"topic":"comments","type":"*"}` {
+		t.Fatalf("unexpected subscription %s", raw)
+	}
+
+	live.ch <- msg(t, rtds.CommentCreated, map[string]interface{}{
+		"id": "2", "body": "newer root", "createdAt": ts(5), "parentEntityID": 42, "parentEntityType": "Event",
+		"profile": map[string]interface{}{"name": "alice", "proxyWallet": "0xabc"},
+	})
+	live.ch <- msg(t, rtds.CommentCreated, map[string]interface{}{
+		"id": 3, "body": "reply", "createdAt": ts(6), "parentCommentID": "1", "parentEntityID": 42, "parentEntityType": "Event",
+	})
+	// Other entity and duplicate are ignored.
+	live.ch <- msg(t, rtds.CommentCreated, map[string]interface{}{"id": "9", "parentEntityID": 7, "parentEntityType": "Event"})
+	live.ch <- msg(t, rtds.CommentCreated, map[string]interface{}{"id": "2", "body": "dup", "parentEntityID": 42})
+	live.ch <- msg(t, rtds.ReactionCreated, map[string]interface{}{"id": "r1", "commentID": 1})
+	live.ch <- msg(t, rtds.ReactionCreated, map[string]interface{}{"id": "r1", "commentID": 1})
+	live.ch <- msg(t, rtds.CommentRemoved, map[string]interface{}{"id": "1"})
+	for i := 0; i < 4; i++ {
+		select {
+		case <-changed:
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after %d changes", i)
+		}
+	}
+	close(live.ch)
+	if err := thread.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	snap := thread.Snapshot()
+	if got := ids(snap); len(got) != 3 || got[0] != "2" || got[1] != "1" || got[2] != "3" {
+		t.Fatalf("unexpected order %v", got)
+	}
+	if snap[0].Profile.Name != "alice" || snap[0].Body != "newer root" {
+		t.Fatalf("unexpected live comment %+v", snap[0])
+	}
+	root := snap[1]
+	if !root.Removed || root.Body != "" || root.ReactionCount != 2 {
+		t.Fatalf("expected tombstoned root with 2 reactions, got %+v", root)
+	}
+	kinds := []ChangeKind{ChangeAdded, ChangeAdded, ChangeAdded, ChangeReaction, ChangeRemoved}
+	if len(changes) != len(kinds) {
+		t.Fatalf("expected %d changes, got %+v", len(kinds), changes)
+	}
+	for i, kind := range kinds {
+		if changes[i].Kind != kind {
+			t.Fatalf("change %d: expected %s, got %s", i, kind, changes[i].Kind)
+		}
+	}
+	if changes[0].Live || !changes[1].Live {
+		t.Fatalf("unexpected live flags: %+v", changes)
+	}
+}
+
+func TestCommentThread_RemovalAndReactions(t *testing.T) {
+	thread, _ := NewCommentThread(nil, nil, Config{EntityType: "Event", EntityID: "1"})
+	created := func(id, parent string, min int) rtds.RtdsMessage {
+		return msg(t, rtds.CommentCreated, map[string]interface{}{"id": id, "parentCommentID": parent, "createdAt": ts(min)})
+	}
+	thread.Apply(created("1", "", 1))
+	thread.Apply(created("2", "1", 2))
+
+	if thread.Apply(msg(t, rtds.ReactionRemoved, map[string]interface{}{"id": "rx", "commentID": "2"})) {
+		t.Fatal("removing an unknown reaction from a zero count should be ignored")
+	}
+	thread.Apply(msg(t, rtds.ReactionCreated, map[string]interface{}{"id": "r1", "commentID": "2"}))
+	thread.Apply(msg(t, rtds.ReactionRemoved, map[string]interface{}{"id": "r1", "commentID": "2"}))
+	if c, _ := thread.Get("2"); c.ReactionCount != 0 {
+		t.Fatalf("expected reaction count back to 0, got %d", c.ReactionCount)
+	}
+
+	thread.Apply(msg(t, rtds.CommentRemoved, map[string]interface{}{"id": "1"}))
+	if c, ok := thread.Get("1"); !ok || !c.Removed {
+		t.Fatal("parent with replies should be tombstoned")
+	}
+	thread.Apply(msg(t, rtds.CommentRemoved, map[string]interface{}{"id": "2"}))
+	if thread.Len() != 0 {
+		t.Fatalf("expected tombstone pruned with its last reply, got %d comments", thread.Len())
+	}
+	// A removed comment is not resurrected by a late copy.
+	if thread.Apply(created("2", "1", 2)) {
+		t.Fatal("removed comment was re-added")
+	}
+
+	count := 0
+	for range thread.All() {
+		count++
+	}
+	if count != 0 {
+		t.Fatalf("expected empty iteration, got %d", count)
+	}
+}
+
+func TestCommentThread_OrphanRepliesShownAsRoots(t *testing.T) {
+	thread, _ := NewCommentThread(nil, nil, Config{EntityType: "Event", EntityID: "1"})
+	thread.Apply(msg(t, rtds.CommentCreated, map[string]interface{}{"id": "5", "parentCommentID": "missing", "createdAt": ts(1)}))
+	thread.Apply(msg(t, rtds.CommentCreated, map[string]interface{}{"id": "6", "parentCommentID": "5", "createdAt": ts(2)}))
+
+	var entries []Entry
+	for entry := range thread.All() {
+		entries = append(entries, entry)
+		break
+	}
+	if len(entries) != 1 || entries[0].ID != "5" || entries[0].Depth != 0 {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+	if replies := thread.Replies("5"); len(replies) != 1 || replies[0].ID != "6" {
+		t.Fatalf("unexpected replies %+v", replies)
+	}
+}
+
+func TestCommentThread_BackfillError(t *testing.T) {
+	live := &fakeLive{ch: make(chan rtds.RtdsMessage)}
+	close(live.ch)
+	thread, _ := NewCommentThread(&fakeHistory{err: errors.New("boom")}, live, Config{EntityType: "Event", EntityID: "1"})
+	if err := thread.Start(context.Background()); err == nil {
+		t.Fatal("expected backfill error")
+	}
+	if err := thread.Start(context.Background()); !errors.Is(err, ErrAlreadyStarted) {
+		t.Fatalf("expected ErrAlreadyStarted, got %v", err)
+	}
+}
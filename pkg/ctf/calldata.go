@@ -0,0 +1,115 @@
+package ctf
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+var (
+	parseConditionalTokensABI = sync.OnceValues(func() (abi.ABI, error) {
+		return abi.JSON(strings.NewReader(conditionalTokensABI))
+	})
+	parseNegRiskAdapterABI = sync.OnceValues(func() (abi.ABI, error) {
+		return abi.JSON(strings.NewReader(negRiskAdapterABI))
+	})
+)
+
+// ConditionalTokensABI returns the parsed ABI of the conditional tokens
+// contract methods used by this package.
+func ConditionalTokensABI() (abi.ABI, error) {
+	parsed, err := parseConditionalTokensABI()
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("parse conditional tokens ABI: %w", err)
+	}
+	return parsed, nil
+}
+
+// NegRiskAdapterABI returns the parsed ABI of the neg risk adapter methods
+// used by this package.
+func NegRiskAdapterABI() (abi.ABI, error) {
+	parsed, err := parseNegRiskAdapterABI()
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("parse neg risk ABI: %w", err)
+	}
+	return parsed, nil
+}
+
+// PackSplitPosition validates req and returns the calldata for
+// splitPosition on the conditional tokens contract.
+func PackSplitPosition(req *SplitPositionRequest) ([]byte, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	if req.Amount == nil {
+		return nil, ErrMissingU256Value
+	}
+	if len(req.Partition) == 0 {
+		return nil, fmt.Errorf("partition is required")
+	}
+	return packConditionalTokens("splitPosition",
+		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.Partition, req.Amount)
+}
+
+// PackMergePositions validates req and returns the calldata for
+// mergePositions on the conditional tokens contract.
+func PackMergePositions(req *MergePositionsRequest) ([]byte, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	if req.Amount == nil {
+		return nil, ErrMissingU256Value
+	}
+	if len(req.Partition) == 0 {
+		return nil, fmt.Errorf("partition is required")
+	}
+	return packConditionalTokens("mergePositions",
+		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.Partition, req.Amount)
+}
+
+// PackRedeemPositions validates req and returns the calldata for
+// redeemPositions on the conditional tokens contract.
+func PackRedeemPositions(req *RedeemPositionsRequest) ([]byte, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	if len(req.IndexSets) == 0 {
+		return nil, fmt.Errorf("index_sets is required")
+	}
+	return packConditionalTokens("redeemPositions",
+		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.IndexSets)
+}
+
+// PackRedeemNegRisk validates req and returns the calldata for
+// redeemPositions on the neg risk adapter.
+func PackRedeemNegRisk(req *RedeemNegRiskRequest) ([]byte, error) {
+	if req == nil {
+		return nil, ErrMissingRequest
+	}
+	if len(req.Amounts) == 0 {
+		return nil, fmt.Errorf("amounts is required")
+	}
+	parsed, err := NegRiskAdapterABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack("redeemPositions", req.ConditionID, req.Amounts)
+	if err != nil {
+		return nil, fmt.Errorf("pack redeemPositions: %w", err)
+	}
+	return data, nil
+}
+
+func packConditionalTokens(method string, args ...interface{}) ([]byte, error) {
+	parsed, err := ConditionalTokensABI()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s: %w", method, err)
+	}
+	return data, nil
+}
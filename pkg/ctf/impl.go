@@ -5,12 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 
 	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -60,17 +59,17 @@ func newClientWithConfig(backend Backend, txOpts *bind.TransactOpts, chainID int
 	if !ok {
 		return nil, ErrConfigNotFound
 	}
-	contractABI, err := abi.JSON(strings.NewReader(conditionalTokensABI))
+	contractABI, err := ConditionalTokensABI()
 	if err != nil {
-		return nil, fmt.Errorf("parse conditional tokens ABI: %w", err)
+		return nil, err
 	}
 	contract := bind.NewBoundContract(cfg.ConditionalTokens, contractABI, backend, backend, backend)
 
 	var neg *bind.BoundContract
 	if cfg.NegRiskAdapter != nil {
-		negABI, err := abi.JSON(strings.NewReader(negRiskAdapterABI))
+		negABI, err := NegRiskAdapterABI()
 		if err != nil {
-			return nil, fmt.Errorf("parse neg risk ABI: %w", err)
+			return nil, err
 		}
 		neg = bind.NewBoundContract(*cfg.NegRiskAdapter, negABI, backend, backend, backend)
 	}
@@ -139,17 +138,11 @@ func (c *clientImpl) PositionID(ctx context.Context, req *PositionIDRequest) (Po
 }
 
 func (c *clientImpl) SplitPosition(ctx context.Context, req *SplitPositionRequest) (SplitPositionResponse, error) {
-	if req == nil {
-		return SplitPositionResponse{}, ErrMissingRequest
-	}
-	if req.Amount == nil {
-		return SplitPositionResponse{}, ErrMissingU256Value
-	}
-	if len(req.Partition) == 0 {
-		return SplitPositionResponse{}, fmt.Errorf("partition is required")
+	data, err := PackSplitPosition(req)
+	if err != nil {
+		return SplitPositionResponse{}, err
 	}
-	tx, err := c.transact(ctx, c.conditionalTokens, "splitPosition",
-		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.Partition, req.Amount)
+	tx, err := c.transactData(ctx, c.conditionalTokens, "splitPosition", data)
 	if err != nil {
 		return SplitPositionResponse{}, err
 	}
@@ -157,17 +150,11 @@ func (c *clientImpl) SplitPosition(ctx context.Context, req *SplitPositionReques
 }
 
 func (c *clientImpl) MergePositions(ctx context.Context, req *MergePositionsRequest) (MergePositionsResponse, error) {
-	if req == nil {
-		return MergePositionsResponse{}, ErrMissingRequest
-	}
-	if req.Amount == nil {
-		return MergePositionsResponse{}, ErrMissingU256Value
-	}
-	if len(req.Partition) == 0 {
-		return MergePositionsResponse{}, fmt.Errorf("partition is required")
+	data, err := PackMergePositions(req)
+	if err != nil {
+		return MergePositionsResponse{}, err
 	}
-	tx, err := c.transact(ctx, c.conditionalTokens, "mergePositions",
-		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.Partition, req.Amount)
+	tx, err := c.transactData(ctx, c.conditionalTokens, "mergePositions", data)
 	if err != nil {
 		return MergePositionsResponse{}, err
 	}
@@ -175,14 +162,11 @@ func (c *clientImpl) MergePositions(ctx context.Context, req *MergePositionsRequ
 }
 
 func (c *clientImpl) RedeemPositions(ctx context.Context, req *RedeemPositionsRequest) (RedeemPositionsResponse, error) {
-	if req == nil {
-		return RedeemPositionsResponse{}, ErrMissingRequest
-	}
-	if len(req.IndexSets) == 0 {
-		return RedeemPositionsResponse{}, fmt.Errorf("index_sets is required")
+	data, err := PackRedeemPositions(req)
+	if err != nil {
+		return RedeemPositionsResponse{}, err
 	}
-	tx, err := c.transact(ctx, c.conditionalTokens, "redeemPositions",
-		req.CollateralToken, req.ParentCollectionID, req.ConditionID, req.IndexSets)
+	tx, err := c.transactData(ctx, c.conditionalTokens, "redeemPositions", data)
 	if err != nil {
 		return RedeemPositionsResponse{}, err
 	}
@@ -190,16 +174,14 @@ func (c *clientImpl) RedeemPositions(ctx context.Context, req *RedeemPositionsRe
 }
 
 func (c *clientImpl) RedeemNegRisk(ctx context.Context, req *RedeemNegRiskRequest) (RedeemNegRiskResponse, error) {
-	if req == nil {
-		return RedeemNegRiskResponse{}, ErrMissingRequest
-	}
-	if len(req.Amounts) == 0 {
-		return RedeemNegRiskResponse{}, fmt.Errorf("amounts is required")
+	data, err := PackRedeemNegRisk(req)
+	if err != nil {
+		return RedeemNegRiskResponse{}, err
 	}
 	if c.negRiskAdapter == nil {
 		return RedeemNegRiskResponse{}, ErrNegRiskAdapter
 	}
-	tx, err := c.transact(ctx, c.negRiskAdapter, "redeemPositions", req.ConditionID, req.Amounts)
+	tx, err := c.transactData(ctx, c.negRiskAdapter, "redeemPositions", data)
 	if err != nil {
 		return RedeemNegRiskResponse{}, err
 	}
@@ -212,6 +194,19 @@ type txResult struct {
 }
 
 func (c *clientImpl) transact(ctx context.Context, contract *bind.BoundContract, method string, args ...interface{}) (txResult, error) {
+	return c.send(ctx, contract, method, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Transact(opts, method, args...)
+	})
+}
+
+// transactData sends calldata already packed by one of the Pack helpers.
+func (c *clientImpl) transactData(ctx context.Context, contract *bind.BoundContract, method string, data []byte) (txResult, error) {
+	return c.send(ctx, contract, method, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.RawTransact(opts, data)
+	})
+}
+
+func (c *clientImpl) send(ctx context.Context, contract *bind.BoundContract, method string, submit func(*bind.TransactOpts) (*types.Transaction, error)) (txResult, error) {
 	if c.backend == nil || contract == nil {
 		return txResult{}, ErrMissingBackend
 	}
@@ -221,7 +216,7 @@ func (c *clientImpl) transact(ctx context.Context, contract *bind.BoundContract,
 	opts := *c.txOpts
 	opts.Context = ctx
 
-	tx, err := contract.Transact(&opts, method, args...)
+	tx, err := submit(&opts)
 	if err != nil {
 		return txResult{}, fmt.Errorf("send %s: %w", method, err)
 	}
@@ -1,6 +1,7 @@
 package ctf
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"math/big"
@@ -375,3 +376,36 @@ func TestResolveConfig(t *testing.T) {
 		}
 	})
 }
+
+func TestPackHelpers(t *testing.T) {
+	ctABI, err := ConditionalTokensABI()
+	if err != nil {
+		t.Fatalf("ConditionalTokensABI: %v", err)
+	}
+	negABI, err := NegRiskAdapterABI()
+	if err != nil {
+		t.Fatalf("NegRiskAdapterABI: %v", err)
+	}
+
+	split, err := PackSplitPosition(&SplitPositionRequest{Partition: BinaryPartition, Amount: big.NewInt(100)})
+	if err != nil {
+		t.Fatalf("PackSplitPosition: %v", err)
+	}
+	if !bytes.Equal(split[:4], ctABI.Methods["splitPosition"].ID) {
+		t.Fatalf("splitPosition selector mismatch: %x", split[:4])
+	}
+	redeem, err := PackRedeemNegRisk(&RedeemNegRiskRequest{Amounts: []*big.Int{big.NewInt(1), big.NewInt(0)}})
+	if err != nil {
+		t.Fatalf("PackRedeemNegRisk: %v", err)
+	}
+	if !bytes.Equal(redeem[:4], negABI.Methods["redeemPositions"].ID) {
+		t.Fatalf("redeemPositions selector mismatch: %x", redeem[:4])
+	}
+
+	if _, err := PackMergePositions(&MergePositionsRequest{Partition: BinaryPartition}); !errors.Is(err, ErrMissingU256Value) {
+		t.Fatalf("expected ErrMissingU256Value, got %v", err)
+	}
+	if _, err := PackRedeemPositions(nil); !errors.Is(err, ErrMissingRequest) {
+		t.Fatalf("expected ErrMissingRequest, got %v", err)
+	}
+}
@@ -1,6 +1,11 @@
 package data
 
-import "context"
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
 
 // Client defines the Data API interface.
 type Client interface {
@@ -23,4 +28,16 @@ type Client interface {
 	MarketPositions(ctx context.Context, req *MarketPositionsRequest) (MarketPositionsResponse, error)
 	ActivityCombos(ctx context.Context, req *ActivityCombosRequest) (ActivityCombosResponse, error)
 	PositionsCombos(ctx context.Context, req *PositionsCombosRequest) (PositionsCombosResponse, error)
+
+	// Iterators walk offset-paginated endpoints page by page. Breaking out of
+	// the loop stops fetching; see package paginate for resume and rate-limit options.
+	PositionsIter(ctx context.Context, req *PositionsRequest, opts ...paginate.Option) iter.Seq2[Position, error]
+	TradesIter(ctx context.Context, req *TradesRequest, opts ...paginate.Option) iter.Seq2[Trade, error]
+	ActivityIter(ctx context.Context, req *ActivityRequest, opts ...paginate.Option) iter.Seq2[Activity, error]
+	ClosedPositionsIter(ctx context.Context, req *ClosedPositionsRequest, opts ...paginate.Option) iter.Seq2[ClosedPosition, error]
+	LeaderboardIter(ctx context.Context, req *LeaderboardRequest, opts ...paginate.Option) iter.Seq2[TraderLeaderboardEntry, error]
+	BuildersLeaderboardIter(ctx context.Context, req *BuildersLeaderboardRequest, opts ...paginate.Option) iter.Seq2[BuilderLeaderboardEntry, error]
+	MarketPositionsIter(ctx context.Context, req *MarketPositionsRequest, opts ...paginate.Option) iter.Seq2[MarketPositionGroup, error]
+	ActivityCombosIter(ctx context.Context, req *ActivityCombosRequest, opts ...paginate.Option) iter.Seq2[ActivityCombo, error]
+	PositionsCombosIter(ctx context.Context, req *PositionsCombosRequest, opts ...paginate.Option) iter.Seq2[PositionCombo, error]
 }
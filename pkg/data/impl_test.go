@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -593,3 +594,30 @@ func TestTradeFilterConstructors(t *testing.T) {
 		t.Errorf("expected TOKENS, got %s", tokens.FilterType)
 	}
 }
+
+func TestTradesIterPagesByOffset(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/trades?limit=2&offset=0&side=": `[{"side":"BUY","timestamp":1},{"side":"BUY","timestamp":2}]`,
+			"/trades?limit=2&offset=2&side=": `[{"side":"SELL","timestamp":3}]`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	var stamps []int64
+	for trade, err := range client.TradesIter(context.Background(), &TradesRequest{}, paginate.WithPageSize(2)) {
+		if err != nil {
+			t.Fatalf("TradesIter failed: %v", err)
+		}
+		stamps = append(stamps, trade.Timestamp)
+	}
+	if fmt.Sprint(stamps) != "[1 2 3]" {
+		t.Fatalf("unexpected trades %v", stamps)
+	}
+
+	for _, err := range client.TradesIter(context.Background(), nil) {
+		if !errors.Is(err, ErrMissingRequest) {
+			t.Fatalf("expected ErrMissingRequest, got %v", err)
+		}
+	}
+}
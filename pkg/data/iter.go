@@ -0,0 +1,140 @@
+package data
+
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+const defaultPageSize = 100
+
+// offsetIter walks a Data API offset endpoint. The request's limit and offset
+// seed the page size and starting point unless overridden by opts; the page
+// size is clamped to the endpoint's maximum limit.
+func offsetIter[T any](ctx context.Context, limit, offset *int, def, max int, opts []paginate.Option, fetch paginate.OffsetFetch[T]) iter.Seq2[T, error] {
+	o := paginate.Apply(opts...)
+	if limit != nil && *limit > 0 {
+		def = *limit
+	}
+	if o.Offset == 0 && offset != nil {
+		o.Offset = *offset
+	}
+	return paginate.Offset(ctx, o.Size(def, max), o, fetch)
+}
+
+func (c *clientImpl) PositionsIter(ctx context.Context, req *PositionsRequest, opts ...paginate.Option) iter.Seq2[Position, error] {
+	if req == nil {
+		return errIter[Position](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 500, opts, func(ctx context.Context, offset, limit int) ([]Position, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Positions(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) TradesIter(ctx context.Context, req *TradesRequest, opts ...paginate.Option) iter.Seq2[Trade, error] {
+	if req == nil {
+		return errIter[Trade](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 10000, opts, func(ctx context.Context, offset, limit int) ([]Trade, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Trades(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) ActivityIter(ctx context.Context, req *ActivityRequest, opts ...paginate.Option) iter.Seq2[Activity, error] {
+	if req == nil {
+		return errIter[Activity](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 500, opts, func(ctx context.Context, offset, limit int) ([]Activity, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Activity(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) ClosedPositionsIter(ctx context.Context, req *ClosedPositionsRequest, opts ...paginate.Option) iter.Seq2[ClosedPosition, error] {
+	if req == nil {
+		return errIter[ClosedPosition](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, 50, 50, opts, func(ctx context.Context, offset, limit int) ([]ClosedPosition, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.ClosedPositions(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) LeaderboardIter(ctx context.Context, req *LeaderboardRequest, opts ...paginate.Option) iter.Seq2[TraderLeaderboardEntry, error] {
+	if req == nil {
+		return errIter[TraderLeaderboardEntry](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, 50, 50, opts, func(ctx context.Context, offset, limit int) ([]TraderLeaderboardEntry, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Leaderboard(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) BuildersLeaderboardIter(ctx context.Context, req *BuildersLeaderboardRequest, opts ...paginate.Option) iter.Seq2[BuilderLeaderboardEntry, error] {
+	if req == nil {
+		return errIter[BuilderLeaderboardEntry](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, 50, 50, opts, func(ctx context.Context, offset, limit int) ([]BuilderLeaderboardEntry, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.BuildersLeaderboard(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) MarketPositionsIter(ctx context.Context, req *MarketPositionsRequest, opts ...paginate.Option) iter.Seq2[MarketPositionGroup, error] {
+	if req == nil {
+		return errIter[MarketPositionGroup](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 0, opts, func(ctx context.Context, offset, limit int) ([]MarketPositionGroup, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.MarketPositions(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) ActivityCombosIter(ctx context.Context, req *ActivityCombosRequest, opts ...paginate.Option) iter.Seq2[ActivityCombo, error] {
+	if req == nil {
+		return errIter[ActivityCombo](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 0, opts, func(ctx context.Context, offset, limit int) ([]ActivityCombo, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.ActivityCombos(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) PositionsCombosIter(ctx context.Context, req *PositionsCombosRequest, opts ...paginate.Option) iter.Seq2[PositionCombo, error] {
+	if req == nil {
+		return errIter[PositionCombo](ErrMissingRequest)
+	}
+	base := *req
+	return offsetIter(ctx, base.Limit, base.Offset, defaultPageSize, 0, opts, func(ctx context.Context, offset, limit int) ([]PositionCombo, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.PositionsCombos(ctx, &nextReq)
+	})
+}
+
+// errIter yields err once.
+func errIter[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
@@ -17,6 +17,7 @@ const (
 	CodeMissingBuilderConfig ErrorCode = "AUTH-003"
 	CodeInvalidSignature     ErrorCode = "AUTH-004"
 	CodeUnauthorized         ErrorCode = "AUTH-005"
+	CodeSignerClosed         ErrorCode = "AUTH-006"
 
 	// Wallet derivation error codes (WALLET-xxx)
 	CodeProxyWalletUnsupported ErrorCode = "WALLET-001"
@@ -31,6 +32,7 @@ const (
 	CodeInvalidPrice      ErrorCode = "CLOB-006"
 	CodeInvalidSize       ErrorCode = "CLOB-007"
 	CodeBatchSizeExceeded ErrorCode = "CLOB-008"
+	CodeClosedOnly        ErrorCode = "CLOB-009"
 
 	// HTTP and Network error codes (NET-xxx)
 	CodeInternalServerError ErrorCode = "NET-001"
@@ -102,6 +104,8 @@ var (
 	ErrInvalidSignature = New(CodeInvalidSignature, "invalid signature")
 	// ErrUnauthorized is returned when authentication fails.
 	ErrUnauthorized = New(CodeUnauthorized, "unauthorized")
+	// ErrSignerClosed is returned when a signer is used after its key was wiped.
+	ErrSignerClosed = New(CodeSignerClosed, "signer is closed")
 )
 
 // Wallet derivation errors
@@ -130,6 +134,8 @@ var (
 	ErrInvalidSize = New(CodeInvalidSize, "invalid size")
 	// ErrBatchSizeExceeded is returned when a batch request exceeds the maximum allowed size.
 	ErrBatchSizeExceeded = New(CodeBatchSizeExceeded, "batch size exceeds maximum")
+	// ErrClosedOnly is returned when the account may only close existing positions.
+	ErrClosedOnly = New(CodeClosedOnly, "account is in closed-only mode")
 )
 
 // HTTP and Network errors
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/ws"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
 )
 
 const (
@@ -100,6 +101,22 @@ func (p WSPolicy) ToCLOBConfig() ws.ClientConfig {
 	}
 }
 
+// ToRTDSConfig converts policy into rtds client config so one policy drives both feeds.
+//
+// RTDS has no ping toggle; DisablePing is ignored and HeartbeatInterval becomes the ping interval.
+func (p WSPolicy) ToRTDSConfig() rtds.ClientConfig {
+	n := p.withDefaults()
+	return rtds.ClientConfig{
+		Reconnect:           n.ReconnectEnabled,
+		ReconnectDelay:      n.ReconnectBaseDelay,
+		ReconnectMaxDelay:   n.ReconnectMaxDelay,
+		ReconnectMultiplier: n.ReconnectMultiplier,
+		ReconnectMax:        n.ReconnectMaxAttempts,
+		PingInterval:        n.HeartbeatInterval,
+		HeartbeatTimeout:    n.HeartbeatTimeout,
+	}
+}
+
 func (p WSPolicy) withDefaults() WSPolicy {
 	n := p
 	if n.ReconnectBaseDelay <= 0 {
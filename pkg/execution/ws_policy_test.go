@@ -90,3 +90,25 @@ func TestWSPolicyToCLOBConfig(t *testing.T) {
 		t.Fatalf("unexpected ws config: %+v", cfg)
 	}
 }
+
+func TestWSPolicyToRTDSConfig(t *testing.T) {
+	p := WSPolicy{
+		ReconnectEnabled:     true,
+		ReconnectBaseDelay:   250 * time.Millisecond,
+		ReconnectMaxDelay:    2 * time.Second,
+		ReconnectMultiplier:  1.5,
+		ReconnectMaxAttempts: 7,
+		HeartbeatInterval:    5 * time.Second,
+		HeartbeatTimeout:     20 * time.Second,
+	}
+	cfg := p.ToRTDSConfig()
+	if !cfg.Reconnect || cfg.ReconnectDelay != 250*time.Millisecond || cfg.ReconnectMaxDelay != 2*time.Second {
+		t.Fatalf("unexpected rtds reconnect config: %+v", cfg)
+	}
+	if cfg.ReconnectMultiplier != 1.5 || cfg.ReconnectMax != 7 {
+		t.Fatalf("unexpected rtds backoff config: %+v", cfg)
+	}
+	if cfg.PingInterval != 5*time.Second || cfg.HeartbeatTimeout != 20*time.Second {
+		t.Fatalf("unexpected rtds heartbeat config: %+v", cfg)
+	}
+}
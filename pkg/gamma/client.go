@@ -5,6 +5,9 @@ package gamma
 
 import (
 	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
 )
 
 // Client defines the interface for the Polymarket Gamma metadata service.
@@ -19,6 +22,8 @@ type Client interface {
 
 	// Teams retrieves a list of teams associated with sports markets.
 	Teams(ctx context.Context, req *TeamsRequest) ([]Team, error)
+	// TeamsIter yields teams page by page; breaking out of the loop stops fetching.
+	TeamsIter(ctx context.Context, req *TeamsRequest, opts ...paginate.Option) iter.Seq2[Team, error]
 	// TeamByID retrieves a specific team by its ID.
 	TeamByID(ctx context.Context, id string) (*Team, error)
 	// Sports retrieves metadata about supported sport categories.
@@ -30,6 +35,8 @@ type Client interface {
 
 	// Tags retrieves a list of market tags (categories).
 	Tags(ctx context.Context, req *TagsRequest) ([]Tag, error)
+	// TagsIter yields tags page by page.
+	TagsIter(ctx context.Context, req *TagsRequest, opts ...paginate.Option) iter.Seq2[Tag, error]
 	// TagByID retrieves a specific tag by its unique ID.
 	TagByID(ctx context.Context, req *TagByIDRequest) (*Tag, error)
 	// TagBySlug retrieves a specific tag by its URL slug.
@@ -49,8 +56,12 @@ type Client interface {
 	Events(ctx context.Context, req *EventsRequest) ([]Event, error)
 	// EventsAll automatically iterates through all pages to retrieve all available events.
 	EventsAll(ctx context.Context, req *EventsRequest) ([]Event, error)
+	// EventsIter yields events page by page without loading them all into memory.
+	EventsIter(ctx context.Context, req *EventsRequest, opts ...paginate.Option) iter.Seq2[Event, error]
 	// EventsKeyset retrieves a cursor-based paginated list of events using keyset pagination.
 	EventsKeyset(ctx context.Context, req *EventsRequest) ([]Event, error)
+	// EventsKeysetIter yields events from the keyset endpoint, following next_cursor.
+	EventsKeysetIter(ctx context.Context, req *EventsRequest, opts ...paginate.Option) iter.Seq2[Event, error]
 	// EventByID retrieves a specific event by its ID.
 	EventByID(ctx context.Context, req *EventByIDRequest) (*Event, error)
 	// EventBySlug retrieves a specific event by its URL slug.
@@ -64,8 +75,12 @@ type Client interface {
 	Markets(ctx context.Context, req *MarketsRequest) ([]Market, error)
 	// MarketsAll automatically iterates through all pages to retrieve all available markets.
 	MarketsAll(ctx context.Context, req *MarketsRequest) ([]Market, error)
+	// MarketsIter yields markets page by page without loading them all into memory.
+	MarketsIter(ctx context.Context, req *MarketsRequest, opts ...paginate.Option) iter.Seq2[Market, error]
 	// MarketsKeyset retrieves a cursor-based paginated list of markets using keyset pagination.
 	MarketsKeyset(ctx context.Context, req *MarketsRequest) ([]Market, error)
+	// MarketsKeysetIter yields markets from the keyset endpoint, following next_cursor.
+	MarketsKeysetIter(ctx context.Context, req *MarketsRequest, opts ...paginate.Option) iter.Seq2[Market, error]
 	// MarketByID retrieves a specific market by its ID.
 	MarketByID(ctx context.Context, req *MarketByIDRequest) (*Market, error)
 	// MarketBySlug retrieves a specific market by its URL slug.
@@ -77,6 +92,8 @@ type Client interface {
 
 	// Series retrieves a list of market series (related groups of events).
 	Series(ctx context.Context, req *SeriesRequest) ([]Series, error)
+	// SeriesIter yields series page by page.
+	SeriesIter(ctx context.Context, req *SeriesRequest, opts ...paginate.Option) iter.Seq2[Series, error]
 	// SeriesByID retrieves a specific series by its ID.
 	SeriesByID(ctx context.Context, req *SeriesByIDRequest) (*Series, error)
 
@@ -84,10 +101,14 @@ type Client interface {
 
 	// Comments retrieves comments for a specific entity (market or event).
 	Comments(ctx context.Context, req *CommentsRequest) ([]Comment, error)
+	// CommentsIter yields an entity's comments page by page.
+	CommentsIter(ctx context.Context, req *CommentsRequest, opts ...paginate.Option) iter.Seq2[Comment, error]
 	// CommentByID retrieves a specific comment by its ID.
 	CommentByID(ctx context.Context, req *CommentByIDRequest) ([]Comment, error)
 	// CommentsByUserAddress retrieves all comments made by a specific wallet address.
 	CommentsByUserAddress(ctx context.Context, req *CommentsByUserAddressRequest) ([]Comment, error)
+	// CommentsByUserAddressIter yields a wallet's comments page by page.
+	CommentsByUserAddressIter(ctx context.Context, req *CommentsByUserAddressRequest, opts ...paginate.Option) iter.Seq2[Comment, error]
 	// PublicProfile retrieves the public user profile associated with a wallet address.
 	PublicProfile(ctx context.Context, req *PublicProfileRequest) (*PublicProfile, error)
 	// PublicSearch performs a global search across markets, events, and tags.
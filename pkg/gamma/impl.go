@@ -240,13 +240,18 @@ func (c *clientImpl) Events(ctx context.Context, req *EventsRequest) ([]Event, e
 }
 
 func (c *clientImpl) EventsKeyset(ctx context.Context, req *EventsRequest) ([]Event, error) {
+	page, err := c.eventsKeysetPage(ctx, req)
+	return page.Items, err
+}
+
+func (c *clientImpl) eventsKeysetPage(ctx context.Context, req *EventsRequest) (keysetPage[Event], error) {
 	q := buildEventsQuery(req)
 	if req != nil && req.NextCursor != "" {
 		q.Set("next_cursor", req.NextCursor)
 	}
-	var resp []Event
-	err := c.httpClient.Get(ctx, "/events/keyset", q, &resp)
-	return resp, err
+	var page keysetPage[Event]
+	err := c.httpClient.Get(ctx, "/events/keyset", q, &page)
+	return page, err
 }
 
 func (c *clientImpl) EventsAll(ctx context.Context, req *EventsRequest) ([]Event, error) {
@@ -363,13 +368,18 @@ func (c *clientImpl) Markets(ctx context.Context, req *MarketsRequest) ([]Market
 }
 
 func (c *clientImpl) MarketsKeyset(ctx context.Context, req *MarketsRequest) ([]Market, error) {
+	page, err := c.marketsKeysetPage(ctx, req)
+	return page.Items, err
+}
+
+func (c *clientImpl) marketsKeysetPage(ctx context.Context, req *MarketsRequest) (keysetPage[Market], error) {
 	q := buildMarketsQuery(req)
 	if req != nil && req.NextCursor != "" {
 		q.Set("next_cursor", req.NextCursor)
 	}
-	var resp []Market
-	err := c.httpClient.Get(ctx, "/markets/keyset", q, &resp)
-	return resp, err
+	var page keysetPage[Market]
+	err := c.httpClient.Get(ctx, "/markets/keyset", q, &page)
+	return page, err
 }
 
 func (c *clientImpl) MarketsAll(ctx context.Context, req *MarketsRequest) ([]Market, error) {
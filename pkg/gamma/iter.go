@@ -0,0 +1,155 @@
+package gamma
+
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+const (
+	defaultPageSize = 100
+	// maxPageSize is the largest limit Gamma honours. A larger request comes
+	// back short, which would end an offset walk after the first page.
+	maxPageSize = 500
+)
+
+// pageSize returns the page size for a walk: the option, else the request's
+// limit, else the default, clamped to Gamma's maximum limit.
+func pageSize(limit *int, o paginate.Options) int {
+	def := defaultPageSize
+	if limit != nil && *limit > 0 {
+		def = *limit
+	}
+	return o.Size(def, maxPageSize)
+}
+
+// offsetIter walks a Gamma offset endpoint. The request's limit and offset
+// seed the page size and starting point unless overridden by opts.
+func offsetIter[T any](ctx context.Context, limit, offset *int, opts []paginate.Option, fetch paginate.OffsetFetch[T]) iter.Seq2[T, error] {
+	o := paginate.Apply(opts...)
+	if o.Offset == 0 && offset != nil {
+		o.Offset = *offset
+	}
+	return paginate.Offset(ctx, pageSize(limit, o), o, fetch)
+}
+
+// keysetIter walks a Gamma keyset endpoint from the request's cursor, or from
+// the cursor in opts when resuming.
+func keysetIter[T any](ctx context.Context, limit *int, cursor string, opts []paginate.Option, fetch func(ctx context.Context, cursor string, limit int) (keysetPage[T], error)) iter.Seq2[T, error] {
+	o := paginate.Apply(opts...)
+	return paginate.Cursor(ctx, cursor, pageSize(limit, o), o, func(ctx context.Context, cursor string, limit int) ([]T, string, error) {
+		page, err := fetch(ctx, cursor, limit)
+		return page.Items, page.NextCursor, err
+	})
+}
+
+func (c *clientImpl) TeamsIter(ctx context.Context, req *TeamsRequest, opts ...paginate.Option) iter.Seq2[Team, error] {
+	base := TeamsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Team, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Teams(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) TagsIter(ctx context.Context, req *TagsRequest, opts ...paginate.Option) iter.Seq2[Tag, error] {
+	base := TagsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Tag, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Tags(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) EventsIter(ctx context.Context, req *EventsRequest, opts ...paginate.Option) iter.Seq2[Event, error] {
+	base := EventsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Event, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Events(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) EventsKeysetIter(ctx context.Context, req *EventsRequest, opts ...paginate.Option) iter.Seq2[Event, error] {
+	base := EventsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return keysetIter(ctx, base.Limit, base.NextCursor, opts, func(ctx context.Context, cursor string, limit int) (keysetPage[Event], error) {
+		nextReq := base
+		nextReq.Offset = nil
+		nextReq.NextCursor, nextReq.Limit = cursor, &limit
+		return c.eventsKeysetPage(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) MarketsIter(ctx context.Context, req *MarketsRequest, opts ...paginate.Option) iter.Seq2[Market, error] {
+	base := MarketsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Market, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Markets(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) MarketsKeysetIter(ctx context.Context, req *MarketsRequest, opts ...paginate.Option) iter.Seq2[Market, error] {
+	base := MarketsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return keysetIter(ctx, base.Limit, base.NextCursor, opts, func(ctx context.Context, cursor string, limit int) (keysetPage[Market], error) {
+		nextReq := base
+		nextReq.Offset = nil
+		nextReq.NextCursor, nextReq.Limit = cursor, &limit
+		return c.marketsKeysetPage(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) SeriesIter(ctx context.Context, req *SeriesRequest, opts ...paginate.Option) iter.Seq2[Series, error] {
+	base := SeriesRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Series, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Series(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) CommentsIter(ctx context.Context, req *CommentsRequest, opts ...paginate.Option) iter.Seq2[Comment, error] {
+	base := CommentsRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Comment, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.Comments(ctx, &nextReq)
+	})
+}
+
+func (c *clientImpl) CommentsByUserAddressIter(ctx context.Context, req *CommentsByUserAddressRequest, opts ...paginate.Option) iter.Seq2[Comment, error] {
+	base := CommentsByUserAddressRequest{}
+	if req != nil {
+		base = *req
+	}
+	return offsetIter(ctx, base.Limit, base.Offset, opts, func(ctx context.Context, offset, limit int) ([]Comment, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = &offset, &limit
+		return c.CommentsByUserAddress(ctx, &nextReq)
+	})
+}
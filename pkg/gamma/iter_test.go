@@ -0,0 +1,122 @@
+package gamma
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+func TestEventsIterPagesByOffset(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/events?limit=2&offset=0": `[{"id":"1"},{"id":"2"}]`,
+			"/events?limit=2&offset=2": `[{"id":"3"}]`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	var ids []string
+	for event, err := range client.EventsIter(context.Background(), &EventsRequest{}, paginate.WithPageSize(2)) {
+		if err != nil {
+			t.Fatalf("EventsIter failed: %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" {
+		t.Fatalf("unexpected events %v", ids)
+	}
+}
+
+func TestMarketsIterClampsPageSize(t *testing.T) {
+	limit := 1000
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/markets?limit=500&offset=0": `[{"id":"1"}]`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+
+	var ids []string
+	for market, err := range client.MarketsIter(context.Background(), &MarketsRequest{Limit: &limit}) {
+		if err != nil {
+			t.Fatalf("MarketsIter failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+	if fmt.Sprint(ids) != "[1]" {
+		t.Fatalf("unexpected markets %v", ids)
+	}
+}
+
+func TestKeysetIterFollowsCursor(t *testing.T) {
+	doer := &staticDoer{
+		responses: map[string]string{
+			"/events/keyset?limit=2":                   `{"data":[{"id":"1"},{"id":"2"}],"next_cursor":"c2"}`,
+			"/events/keyset?limit=2&next_cursor=c2":    `{"data":[{"id":"3"}],"next_cursor":""}`,
+			"/markets/keyset?limit=100":                `{"markets":[{"id":"m1"}],"next_cursor":"n2"}`,
+			"/markets/keyset?limit=100&next_cursor=n2": `{"markets":[{"id":"m2"}],"next_cursor":"n3"}`,
+		},
+	}
+	client := NewClient(transport.NewClient(doer, "http://example"))
+	ctx := context.Background()
+
+	var ids []string
+	var pages []paginate.Page
+	for event, err := range client.EventsKeysetIter(ctx, &EventsRequest{}, paginate.WithPageSize(2), paginate.WithOnPage(func(p paginate.Page) { pages = append(pages, p) })) {
+		if err != nil {
+			t.Fatalf("EventsKeysetIter failed: %v", err)
+		}
+		ids = append(ids, event.ID)
+	}
+	if fmt.Sprint(ids) != "[1 2 3]" || len(pages) != 2 || pages[0].NextCursor != "c2" || !pages[1].Done {
+		t.Fatalf("unexpected events %v pages %+v", ids, pages)
+	}
+
+	// MaxPages stops the walk even though another cursor is available.
+	ids = nil
+	for market, err := range client.MarketsKeysetIter(ctx, nil, paginate.WithMaxPages(2)) {
+		if err != nil {
+			t.Fatalf("MarketsKeysetIter failed: %v", err)
+		}
+		ids = append(ids, market.ID)
+	}
+	if fmt.Sprint(ids) != "[m1 m2]" {
+		t.Fatalf("unexpected markets %v", ids)
+	}
+
+	// Breaking out of the loop stops fetching.
+	for range client.MarketsKeysetIter(ctx, &MarketsRequest{NextCursor: "n2"}) {
+		break
+	}
+}
+
+type badRequestDoer struct{}
+
+func (badRequestDoer) Do(*http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Body:       io.NopCloser(bytes.NewBufferString(`{"error":"bad request"}`)),
+		Header:     make(http.Header),
+	}, nil
+}
+
+func TestIterStopsOnError(t *testing.T) {
+	client := NewClient(transport.NewClient(badRequestDoer{}, "http://example"))
+
+	count := 0
+	for _, err := range client.TagsIter(context.Background(), nil) {
+		count++
+		if err == nil {
+			t.Fatal("expected fetch error")
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected a single error, got %d results", count)
+	}
+}
@@ -1,6 +1,7 @@
 package gamma
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/shopspring/decimal"
@@ -427,6 +428,22 @@ type Comment struct {
 	ReplyAddress     string `json:"replyAddress,omitempty"`
 	CreatedAt        string `json:"createdAt,omitempty"`
 	UpdatedAt        string `json:"updatedAt,omitempty"`
+
+	Profile       *CommentProfile `json:"profile,omitempty"`
+	ReactionCount int             `json:"reactionCount,omitempty"`
+	ReportCount   int             `json:"reportCount,omitempty"`
+}
+
+type CommentProfile struct {
+	Name                  string `json:"name,omitempty"`
+	Pseudonym             string `json:"pseudonym,omitempty"`
+	DisplayUsernamePublic *bool  `json:"displayUsernamePublic,omitempty"`
+	Bio                   string `json:"bio,omitempty"`
+	IsMod                 *bool  `json:"isMod,omitempty"`
+	IsCreator             *bool  `json:"isCreator,omitempty"`
+	ProxyWallet           string `json:"proxyWallet,omitempty"`
+	BaseAddress           string `json:"baseAddress,omitempty"`
+	ProfileImage          string `json:"profileImage,omitempty"`
 }
 
 type PublicProfileUser struct {
@@ -475,3 +492,32 @@ type SearchResults struct {
 }
 
 type StatusResponse string
+
+// keysetPage is one page of a keyset endpoint. The items come with the
+// cursor of the following page, empty on the last one. A bare array is
+// accepted as a single, final page.
+type keysetPage[T any] struct {
+	Items      []T
+	NextCursor string
+}
+
+func (p *keysetPage[T]) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &p.Items)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if cursor, ok := raw["next_cursor"]; ok {
+		if err := json.Unmarshal(cursor, &p.NextCursor); err != nil {
+			return err
+		}
+	}
+	for _, key := range []string{"data", "events", "markets"} {
+		if items, ok := raw[key]; ok {
+			return json.Unmarshal(items, &p.Items)
+		}
+	}
+	return nil
+}
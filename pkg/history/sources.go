@@ -0,0 +1,100 @@
+package history
+
+import (
+	"context"
+	"iter"
+	"strconv"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/data"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+// TradesIterator is the part of clob.Client used by ClobTrades.
+type TradesIterator interface {
+	TradesIter(ctx context.Context, req *clobtypes.TradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error]
+}
+
+// OrdersIterator is the part of clob.Client used by ClobOrders.
+type OrdersIterator interface {
+	OrdersIter(ctx context.Context, req *clobtypes.OrdersRequest, opts ...paginate.Option) iter.Seq2[clobtypes.OrderResponse, error]
+}
+
+// ActivityIterator is the part of data.Client used by DataActivity.
+type ActivityIterator interface {
+	ActivityIter(ctx context.Context, req *data.ActivityRequest, opts ...paginate.Option) iter.Seq2[data.Activity, error]
+}
+
+// ClobTrades syncs the authenticated user's CLOB trades matching req.
+// Incremental runs request trades after the last synced trade time and
+// deduplicate by trade ID.
+func ClobTrades(client TradesIterator, stream string, req clobtypes.TradesRequest) Source[clobtypes.Trade] {
+	return Source[clobtypes.Trade]{
+		Stream: stream,
+		Iter: func(ctx context.Context, from int64, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error] {
+			r := req
+			if from > r.After {
+				r.After = from
+			}
+			return client.TradesIter(ctx, &r, opts...)
+		},
+		Key: func(t clobtypes.Trade) string {
+			if t.ID != "" {
+				return t.ID
+			}
+			return t.TransactionHash
+		},
+		Time: func(t clobtypes.Trade) int64 {
+			if t.Timestamp > 0 {
+				return t.Timestamp
+			}
+			ts, _ := strconv.ParseInt(t.MatchTime, 10, 64)
+			return ts
+		},
+	}
+}
+
+// ClobOrders syncs the authenticated user's open CLOB orders matching req,
+// deduplicated by order ID. The endpoint has no time filter, so every run
+// walks all open orders and only delivers those not seen before.
+func ClobOrders(client OrdersIterator, stream string, req clobtypes.OrdersRequest) Source[clobtypes.OrderResponse] {
+	return Source[clobtypes.OrderResponse]{
+		Stream: stream,
+		Iter: func(ctx context.Context, _ int64, opts ...paginate.Option) iter.Seq2[clobtypes.OrderResponse, error] {
+			r := req
+			return client.OrdersIter(ctx, &r, opts...)
+		},
+		Key: func(o clobtypes.OrderResponse) string { return o.ID },
+		Time: func(o clobtypes.OrderResponse) int64 {
+			ts, _ := strconv.ParseInt(o.CreatedAt, 10, 64)
+			return ts
+		},
+	}
+}
+
+// DataActivity syncs on-chain activity of req.User. Incremental runs request
+// activity from the last synced timestamp and deduplicate by transaction
+// hash, activity type and asset, since one transaction can carry several
+// activities.
+func DataActivity(client ActivityIterator, stream string, req data.ActivityRequest) Source[data.Activity] {
+	return Source[data.Activity]{
+		Stream: stream,
+		Iter: func(ctx context.Context, from int64, opts ...paginate.Option) iter.Seq2[data.Activity, error] {
+			r := req
+			if from > 0 && (r.Start == nil || from > *r.Start) {
+				r.Start = &from
+			}
+			return client.ActivityIter(ctx, &r, opts...)
+		},
+		Key:  activityKey,
+		Time: func(a data.Activity) int64 { return a.Timestamp },
+	}
+}
+
+func activityKey(a data.Activity) string {
+	key := a.TransactionHash.Hex() + ":" + string(a.ActivityType)
+	if a.Asset != nil && a.Asset.Int != nil {
+		key += ":" + a.Asset.String()
+	}
+	return key
+}
@@ -0,0 +1,140 @@
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/internal/atomicfile"
+)
+
+// Checkpoint is the persisted progress of one history stream.
+type Checkpoint struct {
+	Stream string `json:"stream"`
+
+	// InProgress is true while a run has fetched some pages but not finished.
+	// Cursor or Offset then point at the next page to fetch.
+	InProgress bool   `json:"in_progress,omitempty"`
+	Cursor     string `json:"cursor,omitempty"`
+	Offset     int    `json:"offset,omitempty"`
+	// From is the lower time bound (unix seconds) of the run in progress.
+	From int64 `json:"from,omitempty"`
+	// Max is the newest item time seen by the run in progress.
+	Max int64 `json:"max,omitempty"`
+
+	// Since is the newest item time (unix seconds) of the last completed run;
+	// the next run starts from it.
+	Since int64 `json:"since,omitempty"`
+	// Seen holds the keys of the most recently synced items, so items
+	// re-delivered across page or run boundaries are skipped.
+	Seen []string `json:"seen,omitempty"`
+
+	Runs      int       `json:"runs,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Store persists checkpoints. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the checkpoint of stream, or false when there is none.
+	Load(ctx context.Context, stream string) (Checkpoint, bool, error)
+	// Save replaces the checkpoint of cp.Stream.
+	Save(ctx context.Context, cp Checkpoint) error
+}
+
+// MemoryStore keeps checkpoints in memory. It is useful in tests and for
+// processes that only need to resume within their own lifetime.
+type MemoryStore struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// NewMemoryStore creates an empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: make(map[string]Checkpoint)}
+}
+
+func (s *MemoryStore) Load(_ context.Context, stream string) (Checkpoint, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cp, ok := s.checkpoints[stream]
+	cp.Seen = append([]string(nil), cp.Seen...)
+	return cp, ok, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, cp Checkpoint) error {
+	cp.Seen = append([]string(nil), cp.Seen...)
+	s.mu.Lock()
+	s.checkpoints[cp.Stream] = cp
+	s.mu.Unlock()
+	return nil
+}
+
+// FileStore keeps all checkpoints in a single JSON file. Writes go to a
+// temporary file that is renamed into place, so a crash never leaves a
+// truncated checkpoint behind.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileStore creates a store backed by the JSON file at path. The file and
+// its directory are created on the first Save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Load(_ context.Context, stream string) (Checkpoint, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.readLocked()
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+	cp, ok := all[stream]
+	return cp, ok, nil
+}
+
+func (s *FileStore) Save(_ context.Context, cp Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all, err := s.readLocked()
+	if err != nil {
+		return err
+	}
+	all[cp.Stream] = cp
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return fmt.Errorf("history: encode checkpoints: %w", err)
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("history: create checkpoint dir: %w", err)
+	}
+	if err := atomicfile.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("history: write checkpoints: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) readLocked() (map[string]Checkpoint, error) {
+	all := make(map[string]Checkpoint)
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("history: read checkpoints: %w", err)
+	}
+	if len(data) == 0 {
+		return all, nil
+	}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("history: decode checkpoints: %w", err)
+	}
+	return all, nil
+}
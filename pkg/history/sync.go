@@ -0,0 +1,209 @@
+// Package history pulls complete trade, order and activity history through
+// the SDK's paginated iterators, persisting progress to a checkpoint Store.
+//
+// A run that fails part way resumes from the last fully handled page. A run
+// that completes records the newest item time, so the next run only fetches
+// what is new. Items are deduplicated by key (trade ID or transaction hash)
+// across page and run boundaries using a bounded window of recent keys.
+package history
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+const defaultSeenWindow = 5000
+
+var (
+	ErrMissingStream = errors.New("history: stream name is required")
+	ErrMissingStore  = errors.New("history: checkpoint store is required")
+)
+
+// Source describes one history stream.
+type Source[T any] struct {
+	// Stream names the checkpoint. Use a distinct name per account and filter.
+	Stream string
+	// Iter walks the history starting at from (unix seconds, 0 for the
+	// beginning), passing opts through to the paginated iterator.
+	Iter func(ctx context.Context, from int64, opts ...paginate.Option) iter.Seq2[T, error]
+	// Key identifies an item for deduplication.
+	Key func(T) string
+	// Time returns the item's unix time in seconds, or 0 when unknown.
+	Time func(T) int64
+}
+
+// Config tunes a sync run.
+type Config struct {
+	// SeenWindow bounds the number of recent keys kept for deduplication.
+	// Defaults to 5000.
+	SeenWindow int
+	// PageOptions are passed to the iterator, e.g. page size or a rate limiter.
+	PageOptions []paginate.Option
+}
+
+// Result summarizes a sync run.
+type Result struct {
+	Stream     string
+	Pages      int
+	Fetched    int
+	Handled    int
+	Duplicates int
+	// Resumed is true when the run continued an interrupted one.
+	Resumed bool
+	// Since is the newest item time after the run.
+	Since int64
+}
+
+// Sync delivers every item of src not yet synced to handle, in the order the
+// endpoint returns them. Progress is saved after each page; when handle or the
+// fetch fails, the checkpoint keeps the current page so the next run retries
+// it, skipping the items already handled.
+func Sync[T any](ctx context.Context, store Store, src Source[T], cfg Config, handle func(T) error) (Result, error) {
+	if src.Stream == "" {
+		return Result{}, ErrMissingStream
+	}
+	if store == nil {
+		return Result{}, ErrMissingStore
+	}
+	window := cfg.SeenWindow
+	if window <= 0 {
+		window = defaultSeenWindow
+	}
+
+	cp, _, err := store.Load(ctx, src.Stream)
+	if err != nil {
+		return Result{}, err
+	}
+	cp.Stream = src.Stream
+	res := Result{Stream: src.Stream, Resumed: cp.InProgress}
+	if !cp.InProgress {
+		cp.From, cp.Max = cp.Since, cp.Since
+		cp.Cursor, cp.Offset = "", 0
+	}
+	seen := newSeenSet(cp.Seen, window)
+
+	save := func(inProgress bool, cursor string, offset int) error {
+		cp.InProgress = inProgress
+		cp.Cursor, cp.Offset = cursor, offset
+		cp.Seen = seen.keys()
+		cp.UpdatedAt = time.Now().UTC()
+		return store.Save(ctx, cp)
+	}
+
+	// A page is committed once the iterator asks for the next one, which
+	// happens only after all of its items were handled.
+	var (
+		current  = paginate.Page{Cursor: cp.Cursor, Offset: cp.Offset}
+		started  bool
+		saveErr  error
+		pageOpts = append([]paginate.Option(nil), cfg.PageOptions...)
+	)
+	if cp.Cursor != "" {
+		pageOpts = append(pageOpts, paginate.WithCursor(cp.Cursor))
+	}
+	if cp.Offset > 0 {
+		pageOpts = append(pageOpts, paginate.WithOffset(cp.Offset))
+	}
+	pageOpts = append(pageOpts, paginate.WithOnPage(func(p paginate.Page) {
+		if started && saveErr == nil {
+			saveErr = save(true, current.NextCursor, current.NextOffset)
+		}
+		started = true
+		current = p
+		res.Pages++
+	}))
+
+	// abort keeps the run in progress. A fetch error arrives between pages,
+	// so the current page is done; a handle error retries the current page.
+	abort := func(err error, pageDone bool) (Result, error) {
+		cursor, offset := current.Cursor, current.Offset
+		if pageDone && started {
+			cursor, offset = current.NextCursor, current.NextOffset
+		}
+		if saveErr := save(true, cursor, offset); saveErr != nil {
+			return res, errors.Join(err, saveErr)
+		}
+		return res, err
+	}
+
+	for item, err := range src.Iter(ctx, cp.From, pageOpts...) {
+		if err != nil {
+			return abort(err, true)
+		}
+		if saveErr != nil {
+			return res, saveErr
+		}
+		res.Fetched++
+		key := src.Key(item)
+		if key != "" && seen.has(key) {
+			res.Duplicates++
+			continue
+		}
+		if err := handle(item); err != nil {
+			return abort(err, false)
+		}
+		res.Handled++
+		if key != "" {
+			seen.add(key)
+		}
+		if src.Time != nil {
+			if t := src.Time(item); t > cp.Max {
+				cp.Max = t
+			}
+		}
+	}
+	if saveErr != nil {
+		return res, saveErr
+	}
+
+	cp.Since = cp.Max
+	cp.From, cp.Max = 0, 0
+	cp.Runs++
+	res.Since = cp.Since
+	if err := save(false, "", 0); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// seenSet is a FIFO-bounded set of keys.
+type seenSet struct {
+	limit int
+	order []string
+	set   map[string]struct{}
+}
+
+func newSeenSet(keys []string, limit int) *seenSet {
+	s := &seenSet{limit: limit, set: make(map[string]struct{}, len(keys))}
+	for _, k := range keys {
+		s.add(k)
+	}
+	return s
+}
+
+func (s *seenSet) has(key string) bool {
+	_, ok := s.set[key]
+	return ok
+}
+
+func (s *seenSet) add(key string) {
+	if s.has(key) {
+		return
+	}
+	s.order = append(s.order, key)
+	s.set[key] = struct{}{}
+	if over := len(s.order) - s.limit; over > 0 {
+		for _, k := range s.order[:over] {
+			delete(s.set, k)
+		}
+		s.order = append(s.order[:0], s.order[over:]...)
+	}
+}
+
+func (s *seenSet) keys() []string {
+	return append([]string(nil), s.order...)
+}
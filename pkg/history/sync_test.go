@@ -0,0 +1,219 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+type item struct {
+	id string
+	ts int64
+}
+
+// offsetSource serves items in pages of size, filtered by from, and fails
+// once when the page at failAt is requested.
+type offsetSource struct {
+	items   []item
+	size    int
+	failAt  int
+	offsets []int
+}
+
+func (s *offsetSource) source(stream string) Source[item] {
+	return Source[item]{
+		Stream: stream,
+		Iter: func(ctx context.Context, from int64, opts ...paginate.Option) iter.Seq2[item, error] {
+			var filtered []item
+			for _, it := range s.items {
+				if it.ts >= from {
+					filtered = append(filtered, it)
+				}
+			}
+			return paginate.Offset(ctx, s.size, paginate.Apply(opts...), func(ctx context.Context, offset, limit int) ([]item, error) {
+				s.offsets = append(s.offsets, offset)
+				if s.failAt > 0 && offset == s.failAt {
+					s.failAt = 0
+					return nil, errors.New("boom")
+				}
+				end := min(offset+limit, len(filtered))
+				if offset >= end {
+					return nil, nil
+				}
+				return filtered[offset:end], nil
+			})
+		},
+		Key:  func(it item) string { return it.id },
+		Time: func(it item) int64 { return it.ts },
+	}
+}
+
+func ids(items []item) string {
+	var out []string
+	for _, it := range items {
+		out = append(out, it.id)
+	}
+	return fmt.Sprint(out)
+}
+
+func TestSyncResumesAfterFailure(t *testing.T) {
+	src := &offsetSource{
+		items:  []item{{"a", 1}, {"b", 2}, {"c", 3}, {"d", 4}, {"e", 5}},
+		size:   2,
+		failAt: 4,
+	}
+	store := NewMemoryStore()
+	var got []item
+	handle := func(it item) error { got = append(got, it); return nil }
+
+	if _, err := Sync(context.Background(), store, src.source("s"), Config{}, handle); err == nil {
+		t.Fatal("expected fetch error")
+	}
+	cp, ok, _ := store.Load(context.Background(), "s")
+	if !ok || !cp.InProgress || cp.Offset != 4 {
+		t.Fatalf("unexpected checkpoint after failure: %+v", cp)
+	}
+
+	src.offsets = nil
+	res, err := Sync(context.Background(), store, src.source("s"), Config{}, handle)
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if !res.Resumed || fmt.Sprint(src.offsets) != "[4]" {
+		t.Fatalf("expected resume at offset 4, got %+v offsets %v", res, src.offsets)
+	}
+	if ids(got) != "[a b c d e]" {
+		t.Fatalf("got %s", ids(got))
+	}
+	cp, _, _ = store.Load(context.Background(), "s")
+	if cp.InProgress || cp.Since != 5 || cp.Runs != 1 {
+		t.Fatalf("unexpected checkpoint after completion: %+v", cp)
+	}
+}
+
+func TestSyncHandleErrorKeepsPageAndSkipsHandled(t *testing.T) {
+	src := &offsetSource{items: []item{{"a", 1}, {"b", 2}, {"c", 3}}, size: 2}
+	store := NewMemoryStore()
+	var got []item
+	fail := true
+	handle := func(it item) error {
+		if it.id == "b" && fail {
+			fail = false
+			return errors.New("db down")
+		}
+		got = append(got, it)
+		return nil
+	}
+	if _, err := Sync(context.Background(), store, src.source("s"), Config{}, handle); err == nil {
+		t.Fatal("expected handle error")
+	}
+	res, err := Sync(context.Background(), store, src.source("s"), Config{}, handle)
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+	if ids(got) != "[a b c]" || res.Duplicates != 1 {
+		t.Fatalf("got %s duplicates %d", ids(got), res.Duplicates)
+	}
+}
+
+func TestSyncIncrementalSinceLastRun(t *testing.T) {
+	src := &offsetSource{items: []item{{"a", 1}, {"b", 2}}, size: 10}
+	store := NewMemoryStore()
+	var got []item
+	handle := func(it item) error { got = append(got, it); return nil }
+
+	if _, err := Sync(context.Background(), store, src.source("s"), Config{}, handle); err != nil {
+		t.Fatalf("first run: %v", err)
+	}
+	src.items = append(src.items, item{"c", 2}, item{"d", 3})
+	got = nil
+	res, err := Sync(context.Background(), store, src.source("s"), Config{}, handle)
+	if err != nil {
+		t.Fatalf("second run: %v", err)
+	}
+	// "b" shares the boundary timestamp and is fetched again, but deduplicated.
+	if ids(got) != "[c d]" || res.Fetched != 3 || res.Duplicates != 1 || res.Since != 3 {
+		t.Fatalf("got %s result %+v", ids(got), res)
+	}
+}
+
+type fakeTrades struct {
+	pages map[string][]clobtypes.Trade
+	next  map[string]string
+	reqs  []clobtypes.TradesRequest
+}
+
+func (f *fakeTrades) TradesIter(ctx context.Context, req *clobtypes.TradesRequest, opts ...paginate.Option) iter.Seq2[clobtypes.Trade, error] {
+	f.reqs = append(f.reqs, *req)
+	return paginate.Cursor(ctx, "start", 0, paginate.Apply(opts...), func(ctx context.Context, cursor string, limit int) ([]clobtypes.Trade, string, error) {
+		return f.pages[cursor], f.next[cursor], nil
+	})
+}
+
+func TestClobTradesDedupesAcrossPages(t *testing.T) {
+	trades := &fakeTrades{
+		pages: map[string][]clobtypes.Trade{
+			"start": {{ID: "1", Timestamp: 10}, {ID: "2", Timestamp: 11}},
+			"p2":    {{ID: "2", Timestamp: 11}, {ID: "3", MatchTime: "12"}},
+		},
+		next: map[string]string{"start": "p2"},
+	}
+	store := NewFileStore(filepath.Join(t.TempDir(), "state", "checkpoints.json"))
+	var got []string
+	handle := func(tr clobtypes.Trade) error { got = append(got, tr.ID); return nil }
+	src := ClobTrades(trades, "trades", clobtypes.TradesRequest{Market: "m"})
+
+	res, err := Sync(context.Background(), store, src, Config{}, handle)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if fmt.Sprint(got) != "[1 2 3]" || res.Duplicates != 1 || res.Since != 12 || res.Pages != 2 {
+		t.Fatalf("got %v result %+v", got, res)
+	}
+
+	if _, err := Sync(context.Background(), store, src, Config{}, handle); err != nil {
+		t.Fatalf("second sync: %v", err)
+	}
+	if last := trades.reqs[len(trades.reqs)-1]; last.After != 12 || last.Market != "m" {
+		t.Fatalf("expected incremental request, got %+v", last)
+	}
+	if fmt.Sprint(got) != "[1 2 3]" {
+		t.Fatalf("second run re-delivered trades: %v", got)
+	}
+}
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store := NewFileStore(path)
+	if _, ok, err := store.Load(context.Background(), "x"); ok || err != nil {
+		t.Fatalf("empty store: ok=%v err=%v", ok, err)
+	}
+	for i := range 3 {
+		cp := Checkpoint{Stream: "s" + strconv.Itoa(i), Cursor: "c", Offset: i, Seen: []string{"k"}}
+		if err := store.Save(context.Background(), cp); err != nil {
+			t.Fatalf("save: %v", err)
+		}
+	}
+	cp, ok, err := NewFileStore(path).Load(context.Background(), "s2")
+	if err != nil || !ok || cp.Offset != 2 || cp.Cursor != "c" || len(cp.Seen) != 1 {
+		t.Fatalf("load: %+v ok=%v err=%v", cp, ok, err)
+	}
+}
+
+func TestSeenSetWindow(t *testing.T) {
+	s := newSeenSet([]string{"a", "b", "c"}, 2)
+	if s.has("a") || !s.has("b") || !s.has("c") {
+		t.Fatalf("unexpected window %v", s.keys())
+	}
+	s.add("d")
+	if fmt.Sprint(s.keys()) != "[c d]" {
+		t.Fatalf("unexpected window %v", s.keys())
+	}
+}
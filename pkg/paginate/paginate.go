@@ -0,0 +1,276 @@
+// Package paginate turns paginated API endpoints into Go iterators.
+//
+// Iterators fetch one page at a time, so a full history can be walked without
+// holding it in memory. Breaking out of the range loop stops fetching. Each
+// page can wait on a dedicated rate limiter and is retried with backoff when
+// the API answers 429, and every fetched page is reported through OnPage so a
+// walk can be resumed later from the recorded cursor or offset.
+package paginate
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+const (
+	defaultRateLimitRetries = 3
+	defaultRateLimitBackoff = time.Second
+	maxRateLimitBackoff     = 30 * time.Second
+)
+
+// Page describes a fetched page. NextCursor or NextOffset is where a resumed
+// walk should start to continue after this page.
+type Page struct {
+	// Number is the 1-based index of the page within this walk.
+	Number int
+	// Items is the number of items on the page.
+	Items int
+	// Cursor and NextCursor are set by cursor-paged endpoints.
+	Cursor     string
+	NextCursor string
+	// Offset and NextOffset are set by offset-paged endpoints.
+	Offset     int
+	NextOffset int
+	// Done is true on the last page.
+	Done bool
+}
+
+// Options controls a walk. The zero value fetches every page with the
+// endpoint's default page size and no extra rate limiting.
+type Options struct {
+	// PageSize is the page size requested from the API. Endpoints clamp it to
+	// their own limits; zero uses the endpoint default.
+	PageSize int
+	// Cursor resumes a cursor-paged walk. Ignored by offset-paged endpoints.
+	Cursor string
+	// Offset resumes an offset-paged walk. Ignored by cursor-paged endpoints.
+	Offset int
+	// MaxPages stops the walk after this many pages (0 means no limit).
+	MaxPages int
+	// Limiter, when set, is waited on before every page so a long walk does
+	// not starve other callers sharing the same API budget.
+	Limiter *transport.RateLimiter
+	// RateLimitRetries is how many times a page rejected with 429 is retried.
+	// Defaults to 3; negative disables retries.
+	RateLimitRetries int
+	// RateLimitBackoff is the first retry delay, doubled on every attempt up
+	// to 30s. Defaults to one second.
+	RateLimitBackoff time.Duration
+	// OnPage is called after every page, before its items are yielded.
+	OnPage func(Page)
+}
+
+// Option mutates Options.
+type Option func(*Options)
+
+// WithPageSize sets the page size hint.
+func WithPageSize(n int) Option {
+	return func(o *Options) { o.PageSize = n }
+}
+
+// WithCursor resumes a cursor-paged walk from cursor.
+func WithCursor(cursor string) Option {
+	return func(o *Options) { o.Cursor = cursor }
+}
+
+// WithOffset resumes an offset-paged walk from offset.
+func WithOffset(offset int) Option {
+	return func(o *Options) { o.Offset = offset }
+}
+
+// WithMaxPages caps the number of pages fetched.
+func WithMaxPages(n int) Option {
+	return func(o *Options) { o.MaxPages = n }
+}
+
+// WithLimiter waits on limiter before fetching each page.
+func WithLimiter(limiter *transport.RateLimiter) Option {
+	return func(o *Options) { o.Limiter = limiter }
+}
+
+// WithRateLimitRetry configures how pages rejected with 429 are retried.
+func WithRateLimitRetry(retries int, backoff time.Duration) Option {
+	return func(o *Options) {
+		o.RateLimitRetries = retries
+		o.RateLimitBackoff = backoff
+	}
+}
+
+// WithOnPage registers a callback invoked after every page.
+func WithOnPage(fn func(Page)) Option {
+	return func(o *Options) { o.OnPage = fn }
+}
+
+// Apply builds Options from opts.
+func Apply(opts ...Option) Options {
+	var o Options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// Size returns the page size to request: PageSize when set, otherwise def,
+// clamped to max when max > 0.
+func (o Options) Size(def, max int) int {
+	n := o.PageSize
+	if n <= 0 {
+		n = def
+	}
+	if max > 0 && n > max {
+		n = max
+	}
+	return n
+}
+
+// CursorFetch fetches the page at cursor. It returns the items and the cursor
+// of the following page, or "" when there are no more pages.
+type CursorFetch[T any] func(ctx context.Context, cursor string, limit int) ([]T, string, error)
+
+// OffsetFetch fetches up to limit items starting at offset.
+type OffsetFetch[T any] func(ctx context.Context, offset, limit int) ([]T, error)
+
+// Cursor walks a cursor-paged endpoint starting at start, or at o.Cursor when
+// resuming. limit is passed through to fetch unchanged.
+func Cursor[T any](ctx context.Context, start string, limit int, o Options, fetch CursorFetch[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := start
+		if o.Cursor != "" {
+			cursor = o.Cursor
+		}
+		for number := 1; ; number++ {
+			var (
+				items []T
+				next  string
+			)
+			err := o.fetchPage(ctx, func() error {
+				var err error
+				items, next, err = fetch(ctx, cursor, limit)
+				return err
+			})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if next == cursor {
+				next = ""
+			}
+			done := next == "" || (o.MaxPages > 0 && number >= o.MaxPages)
+			if o.OnPage != nil {
+				o.OnPage(Page{Number: number, Items: len(items), Cursor: cursor, NextCursor: next, Done: next == ""})
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if done {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// Offset walks an offset-paged endpoint from o.Offset in steps of limit. The
+// walk ends on the first page shorter than limit.
+func Offset[T any](ctx context.Context, limit int, o Options, fetch OffsetFetch[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := o.Offset
+		if offset < 0 {
+			offset = 0
+		}
+		for number := 1; ; number++ {
+			var items []T
+			err := o.fetchPage(ctx, func() error {
+				var err error
+				items, err = fetch(ctx, offset, limit)
+				return err
+			})
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			last := len(items) == 0 || (limit > 0 && len(items) < limit)
+			next := offset + len(items)
+			if o.OnPage != nil {
+				o.OnPage(Page{Number: number, Items: len(items), Offset: offset, NextOffset: next, Done: last})
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if last || (o.MaxPages > 0 && number >= o.MaxPages) {
+				return
+			}
+			offset = next
+		}
+	}
+}
+
+// fetchPage runs fetch after waiting on the limiter, retrying rate-limited attempts.
+func (o Options) fetchPage(ctx context.Context, fetch func() error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	retries := o.RateLimitRetries
+	if retries == 0 {
+		retries = defaultRateLimitRetries
+	}
+	backoff := o.RateLimitBackoff
+	if backoff <= 0 {
+		backoff = defaultRateLimitBackoff
+	}
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if o.Limiter != nil {
+			if err := o.Limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		err := fetch()
+		if err == nil || !IsRateLimited(err) || attempt >= retries {
+			return err
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > maxRateLimitBackoff {
+			backoff = maxRateLimitBackoff
+		}
+	}
+}
+
+// IsRateLimited reports whether err is an HTTP 429 from any of the SDK clients.
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, sdkerrors.ErrRateLimitExceeded) {
+		return true
+	}
+	var apiErr *types.Error
+	if errors.As(err, &apiErr) && apiErr.Status == 429 {
+		return true
+	}
+	var statusErr interface{ StatusCode() int }
+	return errors.As(err, &statusErr) && statusErr.StatusCode() == 429
+}
@@ -0,0 +1,198 @@
+package paginate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+func cursorPages(pages map[string][]int, next map[string]string, calls *[]string) CursorFetch[int] {
+	return func(ctx context.Context, cursor string, limit int) ([]int, string, error) {
+		*calls = append(*calls, cursor)
+		items, ok := pages[cursor]
+		if !ok {
+			return nil, "", fmt.Errorf("unexpected cursor %q", cursor)
+		}
+		return items, next[cursor], nil
+	}
+}
+
+func TestCursorWalksAllPages(t *testing.T) {
+	var calls []string
+	fetch := cursorPages(
+		map[string][]int{"a": {1, 2}, "b": {3}, "c": {4}},
+		map[string]string{"a": "b", "b": "c", "c": ""},
+		&calls,
+	)
+	var pages []Page
+	var got []int
+	for v, err := range Cursor(context.Background(), "a", 2, Apply(WithOnPage(func(p Page) { pages = append(pages, p) })), fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if fmt.Sprint(got) != "[1 2 3 4]" {
+		t.Fatalf("got %v", got)
+	}
+	if len(pages) != 3 || pages[0].NextCursor != "b" || !pages[2].Done || pages[1].Done {
+		t.Fatalf("unexpected pages %+v", pages)
+	}
+}
+
+func TestCursorEarlyBreakStopsFetching(t *testing.T) {
+	var calls []string
+	fetch := cursorPages(
+		map[string][]int{"a": {1, 2}, "b": {3}},
+		map[string]string{"a": "b"},
+		&calls,
+	)
+	for v := range Cursor(context.Background(), "a", 0, Options{}, fetch) {
+		if v == 1 {
+			break
+		}
+	}
+	if len(calls) != 1 {
+		t.Fatalf("expected a single page fetch, got %v", calls)
+	}
+}
+
+func TestCursorResumeAndRepeatedCursor(t *testing.T) {
+	var calls []string
+	fetch := cursorPages(
+		map[string][]int{"a": {1}, "b": {2}},
+		map[string]string{"a": "b", "b": "b"},
+		&calls,
+	)
+	var got []int
+	for v, err := range Cursor(context.Background(), "a", 0, Apply(WithCursor("b")), fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if fmt.Sprint(got) != "[2]" || fmt.Sprint(calls) != "[b]" {
+		t.Fatalf("got %v after calls %v", got, calls)
+	}
+}
+
+func TestOffsetWalk(t *testing.T) {
+	data := []int{0, 1, 2, 3, 4, 5, 6}
+	var offsets []int
+	fetch := func(ctx context.Context, offset, limit int) ([]int, error) {
+		offsets = append(offsets, offset)
+		end := min(offset+limit, len(data))
+		if offset >= end {
+			return nil, nil
+		}
+		return data[offset:end], nil
+	}
+	var got []int
+	for v, err := range Offset(context.Background(), 3, Apply(WithOffset(1)), fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if fmt.Sprint(got) != "[1 2 3 4 5 6]" {
+		t.Fatalf("got %v", got)
+	}
+	if fmt.Sprint(offsets) != "[1 4 7]" {
+		t.Fatalf("offsets %v", offsets)
+	}
+
+	offsets = nil
+	got = nil
+	for v := range Offset(context.Background(), 2, Apply(WithMaxPages(2)), fetch) {
+		got = append(got, v)
+	}
+	if fmt.Sprint(got) != "[0 1 2 3]" || len(offsets) != 2 {
+		t.Fatalf("max pages: got %v offsets %v", got, offsets)
+	}
+}
+
+func TestFetchErrorEndsWalk(t *testing.T) {
+	boom := errors.New("boom")
+	calls := 0
+	fetch := func(ctx context.Context, offset, limit int) ([]int, error) {
+		calls++
+		return nil, boom
+	}
+	var errs []error
+	for _, err := range Offset(context.Background(), 10, Options{}, fetch) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], boom) || calls != 1 {
+		t.Fatalf("errs=%v calls=%d", errs, calls)
+	}
+}
+
+func TestRateLimitedPageIsRetried(t *testing.T) {
+	calls := 0
+	fetch := func(ctx context.Context, offset, limit int) ([]int, error) {
+		calls++
+		if calls < 3 {
+			return nil, &types.Error{Status: 429, Message: "slow down"}
+		}
+		return []int{1}, nil
+	}
+	var got []int
+	for v, err := range Offset(context.Background(), 10, Apply(WithRateLimitRetry(3, time.Millisecond)), fetch) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if calls != 3 || fmt.Sprint(got) != "[1]" {
+		t.Fatalf("calls=%d got=%v", calls, got)
+	}
+
+	calls = 0
+	fetch = func(ctx context.Context, offset, limit int) ([]int, error) {
+		calls++
+		return nil, sdkerrors.ErrRateLimitExceeded
+	}
+	for _, err := range Offset(context.Background(), 10, Apply(WithRateLimitRetry(-1, 0)), fetch) {
+		if !IsRateLimited(err) {
+			t.Fatalf("expected rate limit error, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("retries disabled but fetched %d times", calls)
+	}
+}
+
+func TestCancelledContextStopsWalk(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	fetched := false
+	fetch := func(ctx context.Context, offset, limit int) ([]int, error) {
+		fetched = true
+		return []int{1}, nil
+	}
+	for _, err := range Offset(ctx, 1, Options{}, fetch) {
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	}
+	if fetched {
+		t.Fatal("fetch called with cancelled context")
+	}
+}
+
+func TestOptionsSize(t *testing.T) {
+	if got := (Options{}).Size(100, 50); got != 50 {
+		t.Fatalf("Size clamp = %d", got)
+	}
+	if got := Apply(WithPageSize(20)).Size(100, 50); got != 20 {
+		t.Fatalf("Size hint = %d", got)
+	}
+	if got := (Options{}).Size(0, 0); got != 0 {
+		t.Fatalf("Size default = %d", got)
+	}
+}
@@ -0,0 +1,215 @@
+// Package pricehistory fetches CLOB price history over arbitrary time ranges
+// and turns it into series that are easy to analyse: multiple tokens merged
+// onto a common forward-filled grid, OHLC candles at any interval, and CSV.
+//
+// The /prices-history endpoint only accepts bounded start/end ranges, so a
+// Fetcher splits longer ranges into windows and stitches the results back
+// together, dropping points duplicated at window boundaries.
+package pricehistory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+)
+
+const (
+	// DefaultMaxWindow is the longest range requested in a single call.
+	DefaultMaxWindow = 7 * 24 * time.Hour
+	// DefaultBatchSize is the number of tokens requested per batch call.
+	DefaultBatchSize = 20
+)
+
+var (
+	ErrMissingClient = errors.New("pricehistory: client is required")
+	ErrMissingToken  = errors.New("pricehistory: token ID is required")
+	ErrInvalidRange  = errors.New("pricehistory: end must be after start")
+	ErrInvalidStep   = errors.New("pricehistory: interval must be positive")
+)
+
+// Client is the part of clob.Client used to fetch price history.
+type Client interface {
+	PricesHistory(ctx context.Context, req *clobtypes.PricesHistoryRequest) (clobtypes.PricesHistoryResponse, error)
+	BatchPricesHistory(ctx context.Context, req *clobtypes.BatchPricesHistoryRequest) (clobtypes.BatchPricesHistoryResponse, error)
+}
+
+// Window is a half-open time range [Start, End).
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Windows splits [start, end) into consecutive windows no longer than max.
+func Windows(start, end time.Time, max time.Duration) []Window {
+	if !end.After(start) {
+		return nil
+	}
+	if max <= 0 {
+		return []Window{{Start: start, End: end}}
+	}
+	var out []Window
+	for s := start; s.Before(end); s = s.Add(max) {
+		e := s.Add(max)
+		if e.After(end) {
+			e = end
+		}
+		out = append(out, Window{Start: s, End: e})
+	}
+	return out
+}
+
+// Range selects the history to fetch, over the half-open range [Start, End).
+type Range struct {
+	Start time.Time
+	End   time.Time
+	// Fidelity is the spacing of the returned points, rounded down to whole
+	// minutes. Zero leaves the API default.
+	Fidelity time.Duration
+}
+
+func (r Range) validate() error {
+	if !r.End.After(r.Start) {
+		return ErrInvalidRange
+	}
+	return nil
+}
+
+func (r Range) fidelityMinutes() int {
+	return int(r.Fidelity / time.Minute)
+}
+
+// Option configures a Fetcher.
+type Option func(*Fetcher)
+
+// WithMaxWindow sets the longest range requested per call. Finer fidelities
+// may need shorter windows.
+func WithMaxWindow(d time.Duration) Option {
+	return func(f *Fetcher) {
+		if d > 0 {
+			f.maxWindow = d
+		}
+	}
+}
+
+// WithBatchSize sets the number of tokens requested per batch call.
+func WithBatchSize(n int) Option {
+	return func(f *Fetcher) {
+		if n > 0 {
+			f.batchSize = n
+		}
+	}
+}
+
+// Fetcher fetches price history over arbitrary ranges.
+type Fetcher struct {
+	client    Client
+	maxWindow time.Duration
+	batchSize int
+}
+
+// NewFetcher creates a Fetcher using client.
+func NewFetcher(client Client, opts ...Option) *Fetcher {
+	f := &Fetcher{client: client, maxWindow: DefaultMaxWindow, batchSize: DefaultBatchSize}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Series fetches the price history of one token over r.
+func (f *Fetcher) Series(ctx context.Context, token string, r Range) (Series, error) {
+	if f == nil || f.client == nil {
+		return Series{}, ErrMissingClient
+	}
+	if token == "" {
+		return Series{}, ErrMissingToken
+	}
+	if err := r.validate(); err != nil {
+		return Series{}, err
+	}
+	s := Series{Token: token}
+	for _, w := range Windows(r.Start, r.End, f.maxWindow) {
+		resp, err := f.client.PricesHistory(ctx, &clobtypes.PricesHistoryRequest{
+			Market:   token,
+			StartTs:  w.Start.Unix(),
+			EndTs:    w.End.Unix(),
+			Fidelity: r.fidelityMinutes(),
+		})
+		if err != nil {
+			return Series{}, fmt.Errorf("pricehistory: %s %s-%s: %w", token, w.Start.UTC().Format(time.RFC3339), w.End.UTC().Format(time.RFC3339), err)
+		}
+		for _, p := range resp {
+			s.Points = append(s.Points, Point{Time: time.Unix(p.Timestamp, 0).UTC(), Price: p.Price})
+		}
+	}
+	s.normalize(r)
+	return s, nil
+}
+
+// Batch fetches the price history of several tokens over r using the batch
+// endpoint. Series are returned in the order of tokens.
+func (f *Fetcher) Batch(ctx context.Context, tokens []string, r Range) ([]Series, error) {
+	if f == nil || f.client == nil {
+		return nil, ErrMissingClient
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	series := make([]Series, len(tokens))
+	index := make(map[string][]int, len(tokens))
+	for i, token := range tokens {
+		if token == "" {
+			return nil, ErrMissingToken
+		}
+		series[i].Token = token
+		index[token] = append(index[token], i)
+	}
+	for start := 0; start < len(tokens); start += f.batchSize {
+		chunk := tokens[start:min(start+f.batchSize, len(tokens))]
+		for _, w := range Windows(r.Start, r.End, f.maxWindow) {
+			startTs, endTs := float64(w.Start.Unix()), float64(w.End.Unix())
+			resp, err := f.client.BatchPricesHistory(ctx, &clobtypes.BatchPricesHistoryRequest{
+				Markets:  chunk,
+				StartTs:  &startTs,
+				EndTs:    &endTs,
+				Fidelity: r.fidelityMinutes(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("pricehistory: batch %s-%s: %w", w.Start.UTC().Format(time.RFC3339), w.End.UTC().Format(time.RFC3339), err)
+			}
+			for token, points := range resp.History {
+				for _, i := range index[token] {
+					for _, p := range points {
+						series[i].Points = append(series[i].Points, Point{Time: time.Unix(p.T, 0).UTC(), Price: p.P})
+					}
+				}
+			}
+		}
+	}
+	for i := range series {
+		series[i].normalize(r)
+	}
+	return series, nil
+}
+
+// normalize sorts points, drops duplicates from overlapping window bounds and
+// trims points outside r.
+func (s *Series) normalize(r Range) {
+	sort.SliceStable(s.Points, func(i, j int) bool { return s.Points[i].Time.Before(s.Points[j].Time) })
+	out := s.Points[:0]
+	for _, p := range s.Points {
+		if p.Time.Before(r.Start) || !p.Time.Before(r.End) {
+			continue
+		}
+		if n := len(out); n > 0 && out[n-1].Time.Equal(p.Time) {
+			out[n-1] = p
+			continue
+		}
+		out = append(out, p)
+	}
+	s.Points = out
+}
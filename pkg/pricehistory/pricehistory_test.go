@@ -0,0 +1,190 @@
+package pricehistory
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+)
+
+var _ Client = clob.Client(nil)
+
+var t0 = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeClient struct {
+	points  map[string][]clobtypes.PriceHistoryPoint
+	single  []clobtypes.PricesHistoryRequest
+	batches []clobtypes.BatchPricesHistoryRequest
+}
+
+func (f *fakeClient) inRange(token string, start, end int64) []clobtypes.PriceHistoryPoint {
+	var out []clobtypes.PriceHistoryPoint
+	for _, p := range f.points[token] {
+		if p.Timestamp >= start && p.Timestamp <= end {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (f *fakeClient) PricesHistory(ctx context.Context, req *clobtypes.PricesHistoryRequest) (clobtypes.PricesHistoryResponse, error) {
+	f.single = append(f.single, *req)
+	return f.inRange(req.Market, req.StartTs, req.EndTs), nil
+}
+
+func (f *fakeClient) BatchPricesHistory(ctx context.Context, req *clobtypes.BatchPricesHistoryRequest) (clobtypes.BatchPricesHistoryResponse, error) {
+	f.batches = append(f.batches, *req)
+	resp := clobtypes.BatchPricesHistoryResponse{History: map[string][]clobtypes.MarketPrice{}}
+	for _, m := range req.Markets {
+		for _, p := range f.inRange(m, int64(*req.StartTs), int64(*req.EndTs)) {
+			resp.History[m] = append(resp.History[m], clobtypes.MarketPrice{T: p.Timestamp, P: p.Price})
+		}
+	}
+	return resp, nil
+}
+
+func at(d time.Duration) int64 { return t0.Add(d).Unix() }
+
+func TestWindows(t *testing.T) {
+	ws := Windows(t0, t0.Add(25*time.Hour), 10*time.Hour)
+	if len(ws) != 3 || !ws[2].Start.Equal(t0.Add(20*time.Hour)) || !ws[2].End.Equal(t0.Add(25*time.Hour)) {
+		t.Fatalf("unexpected windows %+v", ws)
+	}
+	if Windows(t0, t0, time.Hour) != nil {
+		t.Fatal("empty range should yield no windows")
+	}
+}
+
+func TestSeriesSplitsRangeAndDedupesBoundaries(t *testing.T) {
+	client := &fakeClient{points: map[string][]clobtypes.PriceHistoryPoint{
+		"tok": {
+			{Timestamp: at(0), Price: 0.4},
+			{Timestamp: at(time.Hour), Price: 0.5},
+			{Timestamp: at(2 * time.Hour), Price: 0.6},
+			{Timestamp: at(3 * time.Hour), Price: 0.7},
+		},
+	}}
+	f := NewFetcher(client, WithMaxWindow(time.Hour))
+	s, err := f.Series(context.Background(), "tok", Range{Start: t0, End: t0.Add(3 * time.Hour), Fidelity: 5 * time.Minute})
+	if err != nil {
+		t.Fatalf("Series: %v", err)
+	}
+	if len(client.single) != 3 || client.single[0].Fidelity != 5 {
+		t.Fatalf("unexpected requests %+v", client.single)
+	}
+	if len(s.Points) != 3 || s.Points[2].Price != 0.6 {
+		t.Fatalf("unexpected points %+v", s.Points)
+	}
+	if _, err := f.Series(context.Background(), "tok", Range{Start: t0, End: t0}); err != ErrInvalidRange {
+		t.Fatalf("expected ErrInvalidRange, got %v", err)
+	}
+}
+
+func TestSeriesRangeIsHalfOpen(t *testing.T) {
+	client := &fakeClient{points: map[string][]clobtypes.PriceHistoryPoint{
+		"tok": {
+			{Timestamp: at(time.Hour - time.Second), Price: 0.3},
+			{Timestamp: at(time.Hour), Price: 0.4},
+			{Timestamp: at(2*time.Hour - time.Second), Price: 0.5},
+			{Timestamp: at(2 * time.Hour), Price: 0.6},
+		},
+	}}
+	f := NewFetcher(client)
+	s, err := f.Series(context.Background(), "tok", Range{Start: t0.Add(time.Hour), End: t0.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("Series: %v", err)
+	}
+	if len(s.Points) != 2 || s.Points[0].Price != 0.4 || s.Points[1].Price != 0.5 {
+		t.Fatalf("expected points in [Start, End) only, got %+v", s.Points)
+	}
+}
+
+func TestBatchChunksTokens(t *testing.T) {
+	client := &fakeClient{points: map[string][]clobtypes.PriceHistoryPoint{
+		"a": {{Timestamp: at(0), Price: 0.1}},
+		"b": {{Timestamp: at(time.Minute), Price: 0.2}},
+		"c": {{Timestamp: at(2 * time.Minute), Price: 0.3}},
+	}}
+	f := NewFetcher(client, WithBatchSize(2))
+	series, err := f.Batch(context.Background(), []string{"a", "b", "c"}, Range{Start: t0, End: t0.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Batch: %v", err)
+	}
+	if len(client.batches) != 2 || len(client.batches[1].Markets) != 1 {
+		t.Fatalf("unexpected batches %+v", client.batches)
+	}
+	if len(series) != 3 || series[2].Token != "c" || series[2].Points[0].Price != 0.3 {
+		t.Fatalf("unexpected series %+v", series)
+	}
+}
+
+func TestResampleFillsGaps(t *testing.T) {
+	s := Series{Token: "tok", Points: []Point{
+		{Time: t0.Add(10 * time.Second), Price: 0.5},
+		{Time: t0.Add(20 * time.Second), Price: 0.7},
+		{Time: t0.Add(50 * time.Second), Price: 0.4},
+		{Time: t0.Add(3*time.Minute + 5*time.Second), Price: 0.6},
+	}}
+	candles, err := Resample(s, time.Minute)
+	if err != nil {
+		t.Fatalf("Resample: %v", err)
+	}
+	if len(candles) != 4 {
+		t.Fatalf("expected 4 candles, got %+v", candles)
+	}
+	c := candles[0]
+	if !c.Start.Equal(t0) || c.Open != 0.5 || c.High != 0.7 || c.Low != 0.4 || c.Close != 0.4 || c.Points != 3 {
+		t.Fatalf("unexpected first candle %+v", c)
+	}
+	if gap := candles[1]; gap.Points != 0 || gap.Open != 0.4 || gap.Close != 0.4 {
+		t.Fatalf("unexpected gap candle %+v", gap)
+	}
+	if _, err := Resample(s, 0); err != ErrInvalidStep {
+		t.Fatalf("expected ErrInvalidStep, got %v", err)
+	}
+}
+
+func TestAlignForwardFills(t *testing.T) {
+	a := Series{Token: "a", Points: []Point{{Time: t0, Price: 0.1}, {Time: t0.Add(2 * time.Minute), Price: 0.3}}}
+	b := Series{Token: "b", Points: []Point{{Time: t0.Add(90 * time.Second), Price: 0.9}}}
+	frame, err := Align([]Series{a, b}, time.Time{}, time.Time{}, time.Minute)
+	if err != nil {
+		t.Fatalf("Align: %v", err)
+	}
+	if len(frame.Times) != 3 {
+		t.Fatalf("unexpected grid %v", frame.Times)
+	}
+	col, _ := frame.Column("b")
+	if !math.IsNaN(col[0]) || !math.IsNaN(col[1]) || col[2] != 0.9 {
+		t.Fatalf("unexpected column b %v", col)
+	}
+	colA, _ := frame.Column("a")
+	if colA[1] != 0.1 || colA[2] != 0.3 {
+		t.Fatalf("unexpected column a %v", colA)
+	}
+
+	var sb strings.Builder
+	if err := frame.WriteCSV(&sb); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if lines[0] != "timestamp,a,b" || !strings.HasSuffix(lines[1], ",0.1,") || !strings.HasSuffix(lines[3], ",0.3,0.9") {
+		t.Fatalf("unexpected csv:\n%s", sb.String())
+	}
+}
+
+func TestCandlesCSV(t *testing.T) {
+	var sb strings.Builder
+	err := WriteCandlesCSV(&sb, []Candle{{Start: t0, End: t0.Add(time.Minute), Open: 0.5, High: 0.6, Low: 0.4, Close: 0.55, Points: 2}})
+	if err != nil {
+		t.Fatalf("WriteCandlesCSV: %v", err)
+	}
+	want := "start,end,open,high,low,close,points\n1772323200,1772323260,0.5,0.6,0.4,0.55,2\n"
+	if sb.String() != want {
+		t.Fatalf("got %q", sb.String())
+	}
+}
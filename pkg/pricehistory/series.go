@@ -0,0 +1,243 @@
+package pricehistory
+
+import (
+	"encoding/csv"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Point is a single price observation.
+type Point struct {
+	Time  time.Time
+	Price float64
+}
+
+// Series is the price history of one token, ordered by time.
+type Series struct {
+	Token  string
+	Points []Point
+}
+
+// At returns the last price observed at or before t.
+func (s Series) At(t time.Time) (float64, bool) {
+	i := sort.Search(len(s.Points), func(i int) bool { return s.Points[i].Time.After(t) })
+	if i == 0 {
+		return 0, false
+	}
+	return s.Points[i-1].Price, true
+}
+
+// Candle is an OHLC bar. Start is inclusive, End exclusive. A candle with no
+// points is a forward-filled gap whose prices all equal the previous close.
+type Candle struct {
+	Start  time.Time
+	End    time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Points int
+}
+
+// Resample aggregates s into candles of the given interval, aligned to the
+// Unix epoch. Gaps between the first and last point are forward-filled.
+func Resample(s Series, interval time.Duration) ([]Candle, error) {
+	if interval <= 0 {
+		return nil, ErrInvalidStep
+	}
+	var candles []Candle
+	for _, p := range s.Points {
+		start := floorTime(p.Time, interval)
+		if n := len(candles); n > 0 && candles[n-1].Start.Equal(start) {
+			c := &candles[n-1]
+			c.High = math.Max(c.High, p.Price)
+			c.Low = math.Min(c.Low, p.Price)
+			c.Close = p.Price
+			c.Points++
+			continue
+		}
+		if n := len(candles); n > 0 {
+			last := candles[n-1].Close
+			for gap := candles[n-1].End; gap.Before(start); gap = gap.Add(interval) {
+				candles = append(candles, Candle{Start: gap, End: gap.Add(interval), Open: last, High: last, Low: last, Close: last})
+			}
+		}
+		candles = append(candles, Candle{
+			Start:  start,
+			End:    start.Add(interval),
+			Open:   p.Price,
+			High:   p.Price,
+			Low:    p.Price,
+			Close:  p.Price,
+			Points: 1,
+		})
+	}
+	return candles, nil
+}
+
+// Frame holds several series sampled on a common time grid. Values[i][j] is
+// the price of Tokens[j] at Times[i], or NaN before the token's first point.
+type Frame struct {
+	Times  []time.Time
+	Tokens []string
+	Values [][]float64
+}
+
+// Align samples every series on a grid of the given step covering
+// [start, end], forward-filling each token's last known price. Zero start or
+// end default to the earliest and latest point across all series.
+func Align(series []Series, start, end time.Time, step time.Duration) (Frame, error) {
+	if step <= 0 {
+		return Frame{}, ErrInvalidStep
+	}
+	if start.IsZero() || end.IsZero() {
+		first, last := bounds(series)
+		if start.IsZero() {
+			start = first
+		}
+		if end.IsZero() {
+			end = last
+		}
+	}
+	frame := Frame{Tokens: make([]string, len(series))}
+	for j, s := range series {
+		frame.Tokens[j] = s.Token
+	}
+	if start.IsZero() || end.Before(start) {
+		return frame, nil
+	}
+	start = floorTime(start, step)
+	cursors := make([]int, len(series))
+	for t := start; !t.After(end); t = t.Add(step) {
+		row := make([]float64, len(series))
+		for j, s := range series {
+			for cursors[j] < len(s.Points) && !s.Points[cursors[j]].Time.After(t) {
+				cursors[j]++
+			}
+			if cursors[j] == 0 {
+				row[j] = math.NaN()
+			} else {
+				row[j] = s.Points[cursors[j]-1].Price
+			}
+		}
+		frame.Times = append(frame.Times, t)
+		frame.Values = append(frame.Values, row)
+	}
+	return frame, nil
+}
+
+// Column returns the aligned prices of token.
+func (f Frame) Column(token string) ([]float64, bool) {
+	for j, t := range f.Tokens {
+		if t != token {
+			continue
+		}
+		out := make([]float64, len(f.Values))
+		for i, row := range f.Values {
+			out[i] = row[j]
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// WriteCSV writes the series as "timestamp,price" rows with Unix-second
+// timestamps.
+func (s Series) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"timestamp", "price"}); err != nil {
+		return err
+	}
+	for _, p := range s.Points {
+		if err := cw.Write([]string{unix(p.Time), formatPrice(p.Price)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteCSV writes one row per grid time with a column per token. Missing
+// values are left empty.
+func (f Frame) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(append([]string{"timestamp"}, f.Tokens...)); err != nil {
+		return err
+	}
+	for i, t := range f.Times {
+		record := make([]string, 0, len(f.Tokens)+1)
+		record = append(record, unix(t))
+		for _, v := range f.Values[i] {
+			record = append(record, formatPrice(v))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteCandlesCSV writes candles as "start,end,open,high,low,close,points"
+// rows with Unix-second timestamps.
+func WriteCandlesCSV(w io.Writer, candles []Candle) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"start", "end", "open", "high", "low", "close", "points"}); err != nil {
+		return err
+	}
+	for _, c := range candles {
+		record := []string{
+			unix(c.Start),
+			unix(c.End),
+			formatPrice(c.Open),
+			formatPrice(c.High),
+			formatPrice(c.Low),
+			formatPrice(c.Close),
+			strconv.Itoa(c.Points),
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func bounds(series []Series) (first, last time.Time) {
+	for _, s := range series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		if t := s.Points[0].Time; first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if t := s.Points[len(s.Points)-1].Time; t.After(last) {
+			last = t
+		}
+	}
+	return first, last
+}
+
+// floorTime truncates t to a multiple of d since the Unix epoch.
+func floorTime(t time.Time, d time.Duration) time.Time {
+	ns := t.UnixNano()
+	rem := ns % int64(d)
+	if rem < 0 {
+		rem += int64(d)
+	}
+	return time.Unix(0, ns-rem).UTC()
+}
+
+func unix(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func formatPrice(v float64) string {
+	if math.IsNaN(v) {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
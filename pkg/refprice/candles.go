@@ -0,0 +1,361 @@
+// Package refprice aggregates RTDS crypto reference prices into OHLC candles
+// and derives the statistics used by short-horizon "up or down" markets:
+// the opening reference price of a market window, realized volatility,
+// distance to the strike and the Chainlink-vs-exchange basis.
+package refprice
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultInterval = time.Minute
+	defaultHistory  = 500
+
+	year = 365 * 24 * time.Hour
+)
+
+var (
+	ErrUnknownSymbol     = errors.New("refprice: no prices for symbol")
+	ErrOpenNotAvailable  = errors.New("refprice: opening reference price not available")
+	ErrCloseNotAvailable = errors.New("refprice: closing reference price not available")
+	ErrInvalidWindow     = errors.New("refprice: window end must be after start")
+)
+
+// Source identifies the feed a price came from.
+type Source string
+
+const (
+	// SourceExchange is the Binance-style crypto_prices feed.
+	SourceExchange Source = "exchange"
+	// SourceChainlink is the crypto_prices_chainlink feed, which up/down markets resolve against.
+	SourceChainlink Source = "chainlink"
+)
+
+// Candle is an OHLC bar for one symbol and source. Start is inclusive, End exclusive.
+type Candle struct {
+	Symbol string
+	Source Source
+	Start  time.Time
+	End    time.Time
+	Open   decimal.Decimal
+	High   decimal.Decimal
+	Low    decimal.Decimal
+	Close  decimal.Decimal
+	Ticks  int
+}
+
+// Config controls candle width and retention.
+type Config struct {
+	// Interval is the candle width. Defaults to one minute.
+	Interval time.Duration
+	// History is the number of closed candles retained per symbol and source. Defaults to 500.
+	History int
+}
+
+func (c Config) normalize() Config {
+	if c.Interval <= 0 {
+		c.Interval = defaultInterval
+	}
+	if c.History <= 0 {
+		c.History = defaultHistory
+	}
+	return c
+}
+
+type seriesKey struct {
+	symbol string
+	source Source
+}
+
+type series struct {
+	closed  []Candle
+	current *Candle
+	// closeAt is the time of the tick that set current.Close.
+	closeAt time.Time
+	last    decimal.Decimal
+	lastAt  time.Time
+}
+
+// Builder consumes reference prices and maintains candles per symbol and source.
+// It is safe for concurrent use.
+type Builder struct {
+	cfg Config
+
+	mu      sync.RWMutex
+	series  map[seriesKey]*series
+	windows map[windowKey]*trackedWindow
+}
+
+// NewBuilder creates a candle builder.
+func NewBuilder(cfg Config) *Builder {
+	return &Builder{
+		cfg:     cfg.normalize(),
+		series:  make(map[seriesKey]*series),
+		windows: make(map[windowKey]*trackedWindow),
+	}
+}
+
+// Interval returns the configured candle width.
+func (b *Builder) Interval() time.Duration { return b.cfg.Interval }
+
+// NormalizeSymbol maps feed-specific symbols onto a shared base asset so that
+// exchange and Chainlink prices line up: "btcusdt" and "btc/usd" both become "btc".
+func NormalizeSymbol(symbol string) string {
+	s := strings.ToLower(strings.TrimSpace(symbol))
+	if i := strings.IndexAny(s, "/-_"); i > 0 {
+		return s[:i]
+	}
+	for _, quote := range []string{"usdt", "usdc", "usd"} {
+		if strings.HasSuffix(s, quote) && len(s) > len(quote) {
+			return strings.TrimSuffix(s, quote)
+		}
+	}
+	return s
+}
+
+// AddCrypto records an exchange price update.
+func (b *Builder) AddCrypto(ev rtds.CryptoPriceEvent) {
+	b.Add(ev.Symbol, SourceExchange, ev.Value, eventTime(ev.Timestamp, ev.MessageTimestamp))
+}
+
+// AddChainlink records a Chainlink price update.
+func (b *Builder) AddChainlink(ev rtds.ChainlinkPriceEvent) {
+	b.Add(ev.Symbol, SourceChainlink, ev.Value, eventTime(ev.Timestamp, ev.MessageTimestamp))
+}
+
+func eventTime(payload, message int64) time.Time {
+	switch {
+	case payload > 0:
+		return types.UnixTimestamp(payload)
+	case message > 0:
+		return types.UnixTimestamp(message)
+	default:
+		return time.Now().UTC()
+	}
+}
+
+// Add records a price observed at the given time. Ticks older than the
+// current candle are ignored, and an out-of-order tick within it updates
+// High and Low but not Close; non-positive prices are rejected.
+func (b *Builder) Add(symbol string, source Source, price decimal.Decimal, at time.Time) {
+	if !price.IsPositive() {
+		return
+	}
+	key := seriesKey{symbol: NormalizeSymbol(symbol), source: source}
+	start := at.Truncate(b.cfg.Interval)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := b.series[key]
+	if s == nil {
+		s = &series{}
+		b.series[key] = s
+	}
+	if s.current != nil && start.Before(s.current.Start) {
+		return
+	}
+	if s.current != nil && start.After(s.current.Start) {
+		s.closed = append(s.closed, *s.current)
+		if over := len(s.closed) - b.cfg.History; over > 0 {
+			s.closed = append(s.closed[:0], s.closed[over:]...)
+		}
+		s.current = nil
+		b.evictWindowsLocked(key, s.closed[0].Start)
+	}
+	if s.current == nil {
+		s.current = &Candle{
+			Symbol: key.symbol,
+			Source: source,
+			Start:  start,
+			End:    start.Add(b.cfg.Interval),
+			Open:   price,
+			High:   price,
+			Low:    price,
+		}
+	}
+	c := s.current
+	if price.GreaterThan(c.High) {
+		c.High = price
+	}
+	if price.LessThan(c.Low) {
+		c.Low = price
+	}
+	if !at.Before(s.closeAt) {
+		c.Close = price
+		s.closeAt = at
+	}
+	c.Ticks++
+	if !at.Before(s.lastAt) {
+		s.last = price
+		s.lastAt = at
+	}
+	b.observeWindows(key, price, at)
+}
+
+// Run feeds the builder from RTDS streams until ctx is done or both streams
+// close. Either stream may be nil. Stream errors (such as lag) are skipped.
+func (b *Builder) Run(ctx context.Context, crypto *rtds.Stream[rtds.CryptoPriceEvent], chainlink *rtds.Stream[rtds.ChainlinkPriceEvent]) error {
+	var cryptoC <-chan rtds.CryptoPriceEvent
+	var cryptoErr <-chan error
+	if crypto != nil {
+		cryptoC, cryptoErr = crypto.C, crypto.Err
+	}
+	var chainlinkC <-chan rtds.ChainlinkPriceEvent
+	var chainlinkErr <-chan error
+	if chainlink != nil {
+		chainlinkC, chainlinkErr = chainlink.C, chainlink.Err
+	}
+	for cryptoC != nil || chainlinkC != nil {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-cryptoC:
+			if !ok {
+				cryptoC, cryptoErr = nil, nil
+				continue
+			}
+			b.AddCrypto(ev)
+		case ev, ok := <-chainlinkC:
+			if !ok {
+				chainlinkC, chainlinkErr = nil, nil
+				continue
+			}
+			b.AddChainlink(ev)
+		case _, ok := <-cryptoErr:
+			if !ok {
+				cryptoErr = nil
+			}
+		case _, ok := <-chainlinkErr:
+			if !ok {
+				chainlinkErr = nil
+			}
+		}
+	}
+	return nil
+}
+
+// Candles returns retained candles oldest first, including the one still forming.
+func (b *Builder) Candles(symbol string, source Source) []Candle {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	s := b.series[seriesKey{symbol: NormalizeSymbol(symbol), source: source}]
+	if s == nil {
+		return nil
+	}
+	out := make([]Candle, 0, len(s.closed)+1)
+	out = append(out, s.closed...)
+	if s.current != nil {
+		out = append(out, *s.current)
+	}
+	return out
+}
+
+// Current returns the candle still forming.
+func (b *Builder) Current(symbol string, source Source) (Candle, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	s := b.series[seriesKey{symbol: NormalizeSymbol(symbol), source: source}]
+	if s == nil || s.current == nil {
+		return Candle{}, false
+	}
+	return *s.current, true
+}
+
+// Latest returns the most recent price and its timestamp.
+func (b *Builder) Latest(symbol string, source Source) (decimal.Decimal, time.Time, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	s := b.series[seriesKey{symbol: NormalizeSymbol(symbol), source: source}]
+	if s == nil || s.lastAt.IsZero() {
+		return decimal.Zero, time.Time{}, false
+	}
+	return s.last, s.lastAt, true
+}
+
+// RealizedVol returns the annualized standard deviation of log returns between
+// the closes of the last n closed candles. It needs at least three candles.
+func (b *Builder) RealizedVol(symbol string, source Source, n int) (float64, bool) {
+	b.mu.RLock()
+	s := b.series[seriesKey{symbol: NormalizeSymbol(symbol), source: source}]
+	var closes []float64
+	if s != nil {
+		candles := s.closed
+		if n > 0 && len(candles) > n {
+			candles = candles[len(candles)-n:]
+		}
+		closes = make([]float64, 0, len(candles))
+		for _, c := range candles {
+			closes = append(closes, c.Close.InexactFloat64())
+		}
+	}
+	b.mu.RUnlock()
+
+	if len(closes) < 3 {
+		return 0, false
+	}
+	returns := make([]float64, 0, len(closes)-1)
+	for i := 1; i < len(closes); i++ {
+		returns = append(returns, math.Log(closes[i]/closes[i-1]))
+	}
+	var mean float64
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+	var variance float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	variance /= float64(len(returns) - 1)
+	perCandle := math.Sqrt(variance)
+	return perCandle * math.Sqrt(float64(year)/float64(b.cfg.Interval)), true
+}
+
+// Basis compares the latest Chainlink and exchange prices for a symbol.
+type Basis struct {
+	Symbol    string
+	Chainlink decimal.Decimal
+	Exchange  decimal.Decimal
+	// Diff is Chainlink minus exchange.
+	Diff decimal.Decimal
+	// Bps is Diff relative to the exchange price, in basis points.
+	Bps decimal.Decimal
+	// Skew is how much older the Chainlink observation is than the exchange one.
+	Skew        time.Duration
+	ChainlinkAt time.Time
+	ExchangeAt  time.Time
+}
+
+// Basis returns the Chainlink-vs-exchange basis from the latest prices of both feeds.
+func (b *Builder) Basis(symbol string) (Basis, error) {
+	cl, clAt, ok := b.Latest(symbol, SourceChainlink)
+	if !ok {
+		return Basis{}, ErrUnknownSymbol
+	}
+	ex, exAt, ok := b.Latest(symbol, SourceExchange)
+	if !ok {
+		return Basis{}, ErrUnknownSymbol
+	}
+	diff := cl.Sub(ex)
+	return Basis{
+		Symbol:      NormalizeSymbol(symbol),
+		Chainlink:   cl,
+		Exchange:    ex,
+		Diff:        diff,
+		Bps:         diff.Div(ex).Mul(decimal.NewFromInt(10000)),
+		Skew:        exAt.Sub(clAt),
+		ChainlinkAt: clAt,
+		ExchangeAt:  exAt,
+	}, nil
+}
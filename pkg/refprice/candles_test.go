@@ -0,0 +1,279 @@
+package refprice
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/rtds"
+	"github.com/shopspring/decimal"
+)
+
+var t0 = time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+func d(s string) decimal.Decimal { return decimal.RequireFromString(s) }
+
+func TestNormalizeSymbol(t *testing.T) {
+	cases := map[string]string{
+		"btcusdt": "btc",
+		"BTC/USD": "btc",
+		"eth-usd": "eth",
+		"solusdc": "sol",
+		"usd":     "usd",
+		" xrp ":   "xrp",
+	}
+	for in, want := range cases {
+		if got := NormalizeSymbol(in); got != want {
+			t.Errorf("NormalizeSymbol(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestBuilder_CandleRollover(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute, History: 2})
+	b.Add("btcusdt", SourceExchange, d("100"), t0.Add(5*time.Second))
+	b.Add("btcusdt", SourceExchange, d("103"), t0.Add(20*time.Second))
+	b.Add("btcusdt", SourceExchange, d("99"), t0.Add(40*time.Second))
+	b.Add("btcusdt", SourceExchange, d("101"), t0.Add(59*time.Second))
+	b.Add("btcusdt", SourceExchange, d("102"), t0.Add(61*time.Second))
+	// Stale tick from a closed candle is ignored.
+	b.Add("btcusdt", SourceExchange, d("500"), t0.Add(30*time.Second))
+	// Non-positive prices are rejected.
+	b.Add("btcusdt", SourceExchange, decimal.Zero, t0.Add(62*time.Second))
+
+	candles := b.Candles("BTC/USD", SourceExchange)
+	if len(candles) != 2 {
+		t.Fatalf("expected 2 candles, got %d", len(candles))
+	}
+	first := candles[0]
+	if !first.Start.Equal(t0) || !first.End.Equal(t0.Add(time.Minute)) {
+		t.Fatalf("unexpected bounds %s-%s", first.Start, first.End)
+	}
+	if !first.Open.Equal(d("100")) || !first.High.Equal(d("103")) || !first.Low.Equal(d("99")) || !first.Close.Equal(d("101")) || first.Ticks != 4 {
+		t.Fatalf("unexpected OHLC: %+v", first)
+	}
+	cur, ok := b.Current("btc", SourceExchange)
+	if !ok || !cur.Open.Equal(d("102")) || cur.Ticks != 1 {
+		t.Fatalf("unexpected current candle: %+v", cur)
+	}
+	if _, ok := b.Current("btc", SourceChainlink); ok {
+		t.Fatal("sources must be kept apart")
+	}
+
+	for i := 2; i < 6; i++ {
+		b.Add("btc", SourceExchange, d("100"), t0.Add(time.Duration(i)*time.Minute))
+	}
+	if got := len(b.Candles("btc", SourceExchange)); got != 3 {
+		t.Fatalf("expected history trimmed to 2 closed + current, got %d", got)
+	}
+}
+
+func TestBuilder_OutOfOrderTickKeepsClose(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute})
+	b.Add("btc", SourceExchange, d("100"), t0.Add(10*time.Second))
+	b.Add("btc", SourceExchange, d("102"), t0.Add(40*time.Second))
+	// Late tick from earlier in the same candle.
+	b.Add("btc", SourceExchange, d("95"), t0.Add(20*time.Second))
+
+	cur, ok := b.Current("btc", SourceExchange)
+	if !ok || !cur.Close.Equal(d("102")) || !cur.Low.Equal(d("95")) || cur.Ticks != 3 {
+		t.Fatalf("unexpected current candle: %+v", cur)
+	}
+}
+
+func TestBuilder_EvictsWindowsPastHistory(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute, History: 2})
+	w := Window{Symbol: "btc", Start: t0, End: t0.Add(time.Minute)}
+	if err := b.Track(w); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		b.Add("btc", SourceChainlink, d("100"), t0.Add(time.Duration(i)*time.Minute))
+	}
+	if got := len(b.windows); got != 1 {
+		t.Fatalf("window evicted while still within history: %d", got)
+	}
+	b.Add("btc", SourceChainlink, d("100"), t0.Add(3*time.Minute))
+	b.Add("btc", SourceChainlink, d("100"), t0.Add(4*time.Minute))
+	if got := len(b.windows); got != 0 {
+		t.Fatalf("expected window past retained history to be evicted, got %d", got)
+	}
+}
+
+func TestBuilder_RealizedVol(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute})
+	if _, ok := b.RealizedVol("btc", SourceExchange, 0); ok {
+		t.Fatal("expected no vol without history")
+	}
+	prices := []string{"100", "101", "100", "101", "100"}
+	for i, p := range prices {
+		b.Add("btc", SourceExchange, d(p), t0.Add(time.Duration(i)*time.Minute))
+	}
+	// Four candles are closed; the fifth is still forming.
+	vol, ok := b.RealizedVol("btc", SourceExchange, 0)
+	if !ok {
+		t.Fatal("expected vol")
+	}
+	up, down := math.Log(1.01), math.Log(100.0/101.0)
+	mean := (up + down + up) / 3
+	variance := ((up-mean)*(up-mean) + (down-mean)*(down-mean) + (up-mean)*(up-mean)) / 2
+	want := math.Sqrt(variance) * math.Sqrt(float64(year)/float64(time.Minute))
+	if math.Abs(vol-want) > 1e-9 {
+		t.Fatalf("vol = %v, want %v", vol, want)
+	}
+}
+
+func TestBuilder_Basis(t *testing.T) {
+	b := NewBuilder(Config{})
+	if _, err := b.Basis("btc"); !errors.Is(err, ErrUnknownSymbol) {
+		t.Fatalf("expected ErrUnknownSymbol, got %v", err)
+	}
+	b.AddCrypto(rtds.CryptoPriceEvent{Symbol: "btcusdt", Value: d("50000"), Timestamp: t0.Add(2 * time.Second).UnixMilli()})
+	b.AddChainlink(rtds.ChainlinkPriceEvent{Symbol: "btc/usd", Value: d("50010"), Timestamp: t0.UnixMilli()})
+
+	basis, err := b.Basis("BTC")
+	if err != nil {
+		t.Fatalf("Basis failed: %v", err)
+	}
+	if !basis.Diff.Equal(d("10")) || !basis.Bps.Equal(d("2")) {
+		t.Fatalf("unexpected basis: %+v", basis)
+	}
+	if basis.Skew != 2*time.Second {
+		t.Fatalf("expected 2s skew, got %s", basis.Skew)
+	}
+}
+
+func TestBuilder_WindowStatus(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute})
+	w := Window{Symbol: "btc/usd", Start: t0.Add(30 * time.Second), End: t0.Add(15*time.Minute + 30*time.Second)}
+	if err := b.Track(w); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if err := b.Track(Window{Symbol: "btc", Start: t0, End: t0}); !errors.Is(err, ErrInvalidWindow) {
+		t.Fatalf("expected ErrInvalidWindow, got %v", err)
+	}
+
+	b.Add("btc/usd", SourceChainlink, d("99"), t0.Add(10*time.Second))
+	if _, err := b.OpeningPrice(w); !errors.Is(err, ErrOpenNotAvailable) {
+		t.Fatalf("expected ErrOpenNotAvailable before start, got %v", err)
+	}
+	b.Add("btc/usd", SourceChainlink, d("100"), t0.Add(31*time.Second))
+	b.Add("btc/usd", SourceChainlink, d("102"), t0.Add(45*time.Second))
+
+	open, err := b.OpeningPrice(w)
+	if err != nil || !open.Equal(d("100")) {
+		t.Fatalf("expected open 100, got %s (%v)", open, err)
+	}
+
+	status, err := b.Status(w, t0.Add(time.Minute), 0)
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.Distance.Equal(d("2")) || !status.DistanceBps.Equal(d("200")) {
+		t.Fatalf("unexpected distance: %+v", status)
+	}
+	if status.Remaining != 14*time.Minute+30*time.Second || status.VolKnown || status.UpProbability != 0 {
+		t.Fatalf("unexpected status: %+v", status)
+	}
+
+	for i := 1; i <= 4; i++ {
+		price := "101"
+		if i%2 == 0 {
+			price = "103"
+		}
+		b.Add("btc", SourceChainlink, d(price), t0.Add(time.Duration(i)*time.Minute))
+	}
+	status, err = b.Status(w, t0.Add(5*time.Minute), 0)
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.VolKnown || status.ZScore <= 0 || status.UpProbability <= 0.5 || status.UpProbability >= 1 {
+		t.Fatalf("expected up-leaning probability, got %+v", status)
+	}
+
+	ended, err := b.Status(w, w.End.Add(time.Second), 0)
+	if err != nil || ended.Remaining != 0 || ended.UpProbability != 1 {
+		t.Fatalf("expected resolved up window, got %+v (%v)", ended, err)
+	}
+}
+
+func TestBuilder_StatusIgnoresTicksAfterEnd(t *testing.T) {
+	b := NewBuilder(Config{Interval: time.Minute})
+	tracked := Window{Symbol: "btc", Start: t0, End: t0.Add(90 * time.Second)}
+	untracked := Window{Symbol: "btc", Start: t0, End: t0.Add(2 * time.Minute)}
+	if err := b.Track(tracked); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	b.Add("btc", SourceChainlink, d("100"), t0)
+	b.Add("btc", SourceChainlink, d("101"), t0.Add(80*time.Second))
+	b.Add("btc", SourceChainlink, d("99"), t0.Add(100*time.Second))
+	b.Add("btc", SourceChainlink, d("98"), t0.Add(130*time.Second))
+
+	status, err := b.Status(tracked, t0.Add(3*time.Minute), 0)
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.Last.Equal(d("101")) || !status.LastAt.Equal(t0.Add(80*time.Second)) || status.UpProbability != 1 {
+		t.Fatalf("expected close 101 at end, got %+v", status)
+	}
+
+	// Untracked windows use the close of the last candle ending by End.
+	status, err = b.Status(untracked, t0.Add(3*time.Minute), 0)
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if !status.Last.Equal(d("99")) || !status.LastAt.Equal(t0.Add(2*time.Minute)) || status.UpProbability != 0 {
+		t.Fatalf("expected close 99 at end, got %+v", status)
+	}
+}
+
+func TestBuilder_OpeningPriceFromAlignedCandle(t *testing.T) {
+	b := NewBuilder(Config{Interval: 5 * time.Minute})
+	b.Add("eth", SourceChainlink, d("2000"), t0.Add(3*time.Second))
+	b.Add("eth", SourceChainlink, d("2010"), t0.Add(4*time.Minute))
+	w := Window{Symbol: "eth", Start: t0, End: t0.Add(time.Hour)}
+	open, err := b.OpeningPrice(w)
+	if err != nil || !open.Equal(d("2000")) {
+		t.Fatalf("expected aligned open 2000, got %s (%v)", open, err)
+	}
+	if err := b.Track(w); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	b.Untrack(w)
+}
+
+func TestBuilder_Run(t *testing.T) {
+	cryptoC := make(chan rtds.CryptoPriceEvent, 2)
+	cryptoErr := make(chan error, 1)
+	chainC := make(chan rtds.ChainlinkPriceEvent, 2)
+	cryptoC <- rtds.CryptoPriceEvent{Symbol: "btcusdt", Value: d("1"), Timestamp: t0.UnixMilli()}
+	cryptoErr <- rtds.LaggedError{Count: 1}
+	chainC <- rtds.ChainlinkPriceEvent{Symbol: "btc/usd", Value: d("2"), Timestamp: t0.UnixMilli()}
+	close(cryptoC)
+	close(cryptoErr)
+	close(chainC)
+
+	b := NewBuilder(Config{})
+	err := b.Run(context.Background(),
+		&rtds.Stream[rtds.CryptoPriceEvent]{C: cryptoC, Err: cryptoErr},
+		&rtds.Stream[rtds.ChainlinkPriceEvent]{C: chainC},
+	)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if _, _, ok := b.Latest("btc", SourceExchange); !ok {
+		t.Fatal("expected exchange price")
+	}
+	if p, _, ok := b.Latest("btc", SourceChainlink); !ok || !p.Equal(d("2")) {
+		t.Fatal("expected chainlink price")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	open := make(chan rtds.CryptoPriceEvent)
+	if err := b.Run(ctx, &rtds.Stream[rtds.CryptoPriceEvent]{C: open}, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
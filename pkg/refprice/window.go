@@ -0,0 +1,247 @@
+package refprice
+
+import (
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Window is the observation period of an up/down market. Such a market
+// resolves "up" when the reference price at End is at or above the opening
+// reference price at Start, which acts as the strike.
+type Window struct {
+	Symbol string
+	Start  time.Time
+	End    time.Time
+	// Source is the reference feed. Defaults to SourceChainlink, the resolution source.
+	Source Source
+}
+
+func (w Window) source() Source {
+	if w.Source == "" {
+		return SourceChainlink
+	}
+	return w.Source
+}
+
+func (w Window) key() windowKey {
+	return windowKey{
+		series: seriesKey{symbol: NormalizeSymbol(w.Symbol), source: w.source()},
+		start:  w.Start.UnixNano(),
+		end:    w.End.UnixNano(),
+	}
+}
+
+type windowKey struct {
+	series     seriesKey
+	start, end int64
+}
+
+type trackedWindow struct {
+	start, end time.Time
+	open       decimal.Decimal
+	set        bool
+	last       decimal.Decimal
+	lastAt     time.Time
+}
+
+// Track starts watching a window so its opening reference price is captured
+// from the first tick at or after Start, and its closing price from the last
+// tick at or before End, even if they are not aligned to a candle. Callers
+// should Untrack a window once its close has been read; windows ending before
+// the oldest retained candle are dropped automatically.
+func (b *Builder) Track(w Window) error {
+	if !w.End.After(w.Start) {
+		return ErrInvalidWindow
+	}
+	key := w.key()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.windows[key]; ok {
+		return nil
+	}
+	tw := &trackedWindow{start: w.Start, end: w.End}
+	if open, ok := b.alignedOpenLocked(key.series, w.Start); ok {
+		tw.open, tw.set = open, true
+	}
+	b.windows[key] = tw
+	return nil
+}
+
+// Untrack stops watching a window.
+func (b *Builder) Untrack(w Window) {
+	b.mu.Lock()
+	delete(b.windows, w.key())
+	b.mu.Unlock()
+}
+
+// evictWindowsLocked drops tracked windows of key that ended before horizon.
+// Caller holds b.mu.
+func (b *Builder) evictWindowsLocked(key seriesKey, horizon time.Time) {
+	for wk, tw := range b.windows {
+		if wk.series == key && tw.end.Before(horizon) {
+			delete(b.windows, wk)
+		}
+	}
+}
+
+// observeWindows captures opening and closing prices for tracked windows.
+// Caller holds b.mu.
+func (b *Builder) observeWindows(key seriesKey, price decimal.Decimal, at time.Time) {
+	for wk, tw := range b.windows {
+		if wk.series != key || at.Before(tw.start) || at.After(tw.end) {
+			continue
+		}
+		if !tw.set && at.Before(tw.end) {
+			tw.open, tw.set = price, true
+		}
+		if !at.Before(tw.lastAt) {
+			tw.last, tw.lastAt = price, at
+		}
+	}
+}
+
+// alignedOpenLocked returns the open of a retained candle starting exactly at start.
+func (b *Builder) alignedOpenLocked(key seriesKey, start time.Time) (decimal.Decimal, bool) {
+	s := b.series[key]
+	if s == nil {
+		return decimal.Zero, false
+	}
+	if s.current != nil && s.current.Start.Equal(start) {
+		return s.current.Open, true
+	}
+	for i := len(s.closed) - 1; i >= 0; i-- {
+		if s.closed[i].Start.Equal(start) {
+			return s.closed[i].Open, true
+		}
+		if s.closed[i].Start.Before(start) {
+			break
+		}
+	}
+	return decimal.Zero, false
+}
+
+// OpeningPrice returns the reference price at the start of the window. Tracked
+// windows use the first tick at or after Start; untracked ones fall back to the
+// open of a candle that starts exactly at Start.
+func (b *Builder) OpeningPrice(w Window) (decimal.Decimal, error) {
+	key := w.key()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if tw, ok := b.windows[key]; ok && tw.set {
+		return tw.open, nil
+	}
+	if open, ok := b.alignedOpenLocked(key.series, w.Start); ok {
+		return open, nil
+	}
+	return decimal.Zero, ErrOpenNotAvailable
+}
+
+// closingPrice returns the last reference price at or before the end of the
+// window. Tracked windows use their last tick; untracked ones fall back to the
+// latest tick when it is not after End, then to the close of the newest
+// candle ending by End, timestamped with the candle's end.
+func (b *Builder) closingPrice(w Window) (decimal.Decimal, time.Time, error) {
+	key := w.key()
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if tw, ok := b.windows[key]; ok && !tw.lastAt.IsZero() {
+		return tw.last, tw.lastAt, nil
+	}
+	s := b.series[key.series]
+	if s == nil {
+		return decimal.Zero, time.Time{}, ErrUnknownSymbol
+	}
+	if !s.lastAt.IsZero() && !s.lastAt.After(w.End) {
+		return s.last, s.lastAt, nil
+	}
+	if s.current != nil && !s.current.End.After(w.End) {
+		return s.current.Close, s.current.End, nil
+	}
+	for i := len(s.closed) - 1; i >= 0; i-- {
+		if c := s.closed[i]; !c.End.After(w.End) {
+			return c.Close, c.End, nil
+		}
+	}
+	return decimal.Zero, time.Time{}, ErrCloseNotAvailable
+}
+
+// WindowStatus summarizes where the reference price stands relative to a window's strike.
+type WindowStatus struct {
+	Window
+	Open   decimal.Decimal
+	Last   decimal.Decimal
+	LastAt time.Time
+	// Distance is Last minus Open; positive means the market is currently "up".
+	Distance decimal.Decimal
+	// DistanceBps is Distance relative to Open, in basis points.
+	DistanceBps decimal.Decimal
+	Remaining   time.Duration
+	// Vol is the annualized realized volatility; VolKnown is false when there is too little history.
+	Vol      float64
+	VolKnown bool
+	// ZScore is log(Last/Open) in units of the volatility expected over Remaining.
+	ZScore float64
+	// UpProbability is the driftless lognormal probability of finishing at or above Open.
+	// Once the window has ended it is 0 or 1 from the closing price; before that it
+	// is zero when Vol is unknown.
+	UpProbability float64
+}
+
+// Status reports the distance to the strike for w at now, using the last
+// volLookback closed candles (all retained candles when volLookback <= 0) for volatility.
+// Once now reaches End, Last is the closing price: the last price at or before
+// End, ignoring ticks that arrived after it.
+func (b *Builder) Status(w Window, now time.Time, volLookback int) (WindowStatus, error) {
+	if !w.End.After(w.Start) {
+		return WindowStatus{}, ErrInvalidWindow
+	}
+	open, err := b.OpeningPrice(w)
+	if err != nil {
+		return WindowStatus{}, err
+	}
+	var (
+		last   decimal.Decimal
+		lastAt time.Time
+	)
+	if now.Before(w.End) {
+		var ok bool
+		if last, lastAt, ok = b.Latest(w.Symbol, w.source()); !ok {
+			return WindowStatus{}, ErrUnknownSymbol
+		}
+	} else if last, lastAt, err = b.closingPrice(w); err != nil {
+		return WindowStatus{}, err
+	}
+
+	status := WindowStatus{
+		Window:      w,
+		Open:        open,
+		Last:        last,
+		LastAt:      lastAt,
+		Distance:    last.Sub(open),
+		DistanceBps: last.Sub(open).Div(open).Mul(decimal.NewFromInt(10000)),
+	}
+	if w.End.After(now) {
+		status.Remaining = w.End.Sub(now)
+	}
+	status.Vol, status.VolKnown = b.RealizedVol(w.Symbol, w.source(), volLookback)
+
+	logDist := math.Log(last.InexactFloat64() / open.InexactFloat64())
+	sigma := status.Vol * math.Sqrt(float64(status.Remaining)/float64(year))
+	switch {
+	case status.Remaining == 0:
+		if logDist >= 0 {
+			status.UpProbability = 1
+		}
+	case status.VolKnown && sigma > 0:
+		status.ZScore = logDist / sigma
+		status.UpProbability = normalCDF(status.ZScore)
+	}
+	return status, nil
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
@@ -4,7 +4,12 @@
 // via the transport client's SetAuth mechanism.
 package relayer
 
-import "context"
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
 
 // Client defines the interface for the Polymarket Relayer API.
 type Client interface {
@@ -17,6 +22,10 @@ type Client interface {
 	// GetTransactions retrieves recent transactions for the authenticated user.
 	GetTransactions(ctx context.Context, req *GetTransactionsRequest) ([]Transaction, error)
 
+	// TransactionsIter yields the user's transactions page by page. Breaking
+	// out of the loop stops fetching.
+	TransactionsIter(ctx context.Context, req *GetTransactionsRequest, opts ...paginate.Option) iter.Seq2[Transaction, error]
+
 	// GetNonce retrieves the current Proxy/Safe nonce for a signer address.
 	GetNonce(ctx context.Context, signer string) (*NonceResponse, error)
 
@@ -0,0 +1,30 @@
+package relayer
+
+import (
+	"context"
+	"iter"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+)
+
+const defaultPageSize = 100
+
+func (c *clientImpl) TransactionsIter(ctx context.Context, req *GetTransactionsRequest, opts ...paginate.Option) iter.Seq2[Transaction, error] {
+	base := GetTransactionsRequest{}
+	if req != nil {
+		base = *req
+	}
+	o := paginate.Apply(opts...)
+	def := defaultPageSize
+	if base.Limit > 0 {
+		def = base.Limit
+	}
+	if o.Offset == 0 {
+		o.Offset = base.Offset
+	}
+	return paginate.Offset(ctx, o.Size(def, 0), o, func(ctx context.Context, offset, limit int) ([]Transaction, error) {
+		nextReq := base
+		nextReq.Offset, nextReq.Limit = offset, limit
+		return c.GetTransactions(ctx, &nextReq)
+	})
+}
@@ -0,0 +1,54 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/paginate"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+func TestTransactionsIterPagesByOffset(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var page []Transaction
+		for i := offset; i < offset+limit && i < 5; i++ {
+			page = append(page, Transaction{ID: strconv.Itoa(i)})
+		}
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer srv.Close()
+	client := NewClient(transport.NewClient(srv.Client(), srv.URL))
+	ctx := context.Background()
+
+	var ids []string
+	for tx, err := range client.TransactionsIter(ctx, &GetTransactionsRequest{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("TransactionsIter failed: %v", err)
+		}
+		ids = append(ids, tx.ID)
+	}
+	if fmt.Sprint(ids) != "[0 1 2 3 4]" || len(requests) != 3 {
+		t.Fatalf("unexpected transactions %v (requests %v)", ids, requests)
+	}
+
+	// A resumed walk starts at the option's offset and honours MaxPages.
+	requests, ids = nil, nil
+	for tx, err := range client.TransactionsIter(ctx, nil, paginate.WithPageSize(1), paginate.WithOffset(3), paginate.WithMaxPages(1)) {
+		if err != nil {
+			t.Fatalf("TransactionsIter failed: %v", err)
+		}
+		ids = append(ids, tx.ID)
+	}
+	if fmt.Sprint(ids) != "[3]" || len(requests) != 1 {
+		t.Fatalf("unexpected transactions %v (requests %v)", ids, requests)
+	}
+}
@@ -0,0 +1,312 @@
+// Package rewards estimates CLOB liquidity rewards for a set of resting
+// quotes and monitors whether those quotes keep scoring.
+//
+// Scoring follows the published liquidity rewards methodology: every order
+// within the market's max spread of the size-adjusted midpoint and at least
+// the minimum size earns ((v-s)/v)^2 per share, where v is the max spread and
+// s the order's distance to the midpoint. Bids on the first outcome and asks
+// on the second count towards one side of the book (Q_one), the mirrored
+// orders towards the other (Q_two), and a maker's score is the two-sided
+// Q_min. A maker earns its share of the market's total Q_min of the daily pool.
+//
+// The market total is approximated from the aggregated book, treating each
+// price level as a separate one-sided maker and adding the estimated maker's
+// own two-sided Q_min, so estimates are most accurate for books that are not
+// dominated by a few large two-sided makers.
+package rewards
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/types"
+)
+
+const (
+	// ScalingFactor is the divisor applied to a one-sided Q score while the
+	// midpoint is within [0.10, 0.90].
+	ScalingFactor = 3.0
+
+	twoSidedLow  = 0.10
+	twoSidedHigh = 0.90
+)
+
+var (
+	ErrInvalidParams = errors.New("rewards: max spread must be positive")
+	ErrNoMidpoint    = errors.New("rewards: book has no two-sided midpoint")
+)
+
+// Reason explains why a quote does not score.
+type Reason string
+
+const (
+	ReasonNone    Reason = ""
+	ReasonSpread  Reason = "spread"
+	ReasonSize    Reason = "size"
+	ReasonUnknown Reason = "unknown"
+)
+
+// Params are a market's reward parameters.
+type Params struct {
+	// MaxSpread is the maximum distance from the midpoint, in price units
+	// (0.03 for a 3 cent spread).
+	MaxSpread float64
+	// MinSize is the minimum order size, in shares, that scores.
+	MinSize float64
+	// DailyRate is the market's daily reward pool.
+	DailyRate float64
+}
+
+// ParamsFromCurrentReward converts a RewardsMarketsCurrent entry.
+func ParamsFromCurrentReward(r clobtypes.CurrentReward) (Params, error) {
+	rates := make([]string, 0, len(r.RewardsConfig))
+	for _, c := range r.RewardsConfig {
+		rates = append(rates, c.RatePerDay)
+	}
+	return parseParams(r.RewardsMaxSpread, r.RewardsMinSize, rates)
+}
+
+// ParamsFromMarketReward converts a RewardsMarkets entry.
+func ParamsFromMarketReward(r clobtypes.MarketReward) (Params, error) {
+	rates := make([]string, 0, len(r.RewardsConfig))
+	for _, c := range r.RewardsConfig {
+		rates = append(rates, c.RatePerDay)
+	}
+	return parseParams(r.RewardsMaxSpread, r.RewardsMinSize, rates)
+}
+
+// parseParams parses API reward fields. The API reports max spread in cents.
+func parseParams(maxSpread, minSize string, rates []string) (Params, error) {
+	cents, err := types.ParseDecimal(maxSpread)
+	if err != nil {
+		return Params{}, fmt.Errorf("rewards: max spread: %w", err)
+	}
+	size, err := types.ParseDecimal(minSize)
+	if err != nil {
+		return Params{}, fmt.Errorf("rewards: min size: %w", err)
+	}
+	daily := decimal.Zero
+	for _, rate := range rates {
+		v, err := types.ParseDecimal(rate)
+		if err != nil {
+			return Params{}, fmt.Errorf("rewards: rate per day: %w", err)
+		}
+		daily = daily.Add(v)
+	}
+	return Params{
+		MaxSpread: cents.Div(decimal.NewFromInt(100)).InexactFloat64(),
+		MinSize:   size.InexactFloat64(),
+		DailyRate: daily.InexactFloat64(),
+	}, nil
+}
+
+// Quote is a resting order to score.
+type Quote struct {
+	ID      string
+	TokenID string
+	// Side is BUY or SELL.
+	Side  string
+	Price float64
+	// Size is the unfilled size in shares.
+	Size float64
+}
+
+// QuoteFromOrder converts an open order, using its unmatched size.
+func QuoteFromOrder(o clobtypes.OrderResponse) (Quote, error) {
+	price, err := types.ParseDecimal(o.Price)
+	if err != nil {
+		return Quote{}, fmt.Errorf("rewards: order %s price: %w", o.ID, err)
+	}
+	size, err := types.ParseDecimal(o.OriginalSize)
+	if err != nil {
+		return Quote{}, fmt.Errorf("rewards: order %s size: %w", o.ID, err)
+	}
+	matched, _ := types.ParseDecimal(o.SizeMatched)
+	return Quote{
+		ID:      o.ID,
+		TokenID: o.AssetID,
+		Side:    strings.ToUpper(o.Side),
+		Price:   price.InexactFloat64(),
+		Size:    math.Max(size.Sub(matched).InexactFloat64(), 0),
+	}, nil
+}
+
+// Input is the state to estimate rewards for.
+type Input struct {
+	Params Params
+	// Book is the order book of one outcome token. Quotes on any other token
+	// are treated as quotes on the complementary outcome and mirrored.
+	Book clobtypes.OrderBook
+	// Quotes are the maker's resting orders on either outcome.
+	Quotes []Quote
+	// AddToBook adds the quotes to the book's liquidity. Leave false when the
+	// quotes are already resting and therefore part of Book.
+	AddToBook bool
+}
+
+// QuoteScore is the score of one quote.
+type QuoteScore struct {
+	Quote
+	// Spread is the quote's distance from the midpoint, in price units.
+	Spread float64
+	// Score is the per-share score; Q adds size weighting.
+	Score float64
+	Q     float64
+	// Reason explains a zero score.
+	Reason Reason
+}
+
+// Estimate is the expected reward share of a set of quotes.
+type Estimate struct {
+	Midpoint float64
+	Quotes   []QuoteScore
+	// QOne and QTwo are the maker's scores on each side of the book.
+	QOne float64
+	QTwo float64
+	// QMin is the maker's two-sided score.
+	QMin float64
+	// MarketQMin is the approximate total score of the market.
+	MarketQMin float64
+	// Share is QMin as a fraction of MarketQMin.
+	Share float64
+	// DailyEarnings is Share of the daily pool.
+	DailyEarnings float64
+}
+
+// Scoring reports whether the quote earns rewards.
+func (q QuoteScore) Scoring() bool { return q.Q > 0 }
+
+// OrderScore returns the per-share score of an order spread away from the
+// midpoint, or zero when it is outside maxSpread.
+func OrderScore(maxSpread, spread float64) float64 {
+	if maxSpread <= 0 || spread < 0 || spread >= maxSpread {
+		return 0
+	}
+	r := (maxSpread - spread) / maxSpread
+	return r * r
+}
+
+// QMin combines the two sides of a maker's score. Within [0.10, 0.90] a
+// one-sided maker still scores a third of its side; outside, both sides are
+// required.
+func QMin(qOne, qTwo, midpoint float64) float64 {
+	if midpoint >= twoSidedLow && midpoint <= twoSidedHigh {
+		return math.Max(math.Min(qOne, qTwo), math.Max(qOne/ScalingFactor, qTwo/ScalingFactor))
+	}
+	return math.Min(qOne, qTwo)
+}
+
+// AdjustedMidpoint returns the midpoint of the best bid and ask, ignoring
+// levels smaller than minSize.
+func AdjustedMidpoint(book clobtypes.OrderBook, minSize float64) (float64, bool) {
+	bid, okBid := bestLevel(book.Bids, minSize, func(a, b float64) bool { return a > b })
+	ask, okAsk := bestLevel(book.Asks, minSize, func(a, b float64) bool { return a < b })
+	if !okBid || !okAsk {
+		return 0, false
+	}
+	return (bid + ask) / 2, true
+}
+
+// Compute estimates the expected Q score share and daily earnings of quotes.
+func Compute(in Input) (Estimate, error) {
+	p := in.Params
+	if p.MaxSpread <= 0 {
+		return Estimate{}, ErrInvalidParams
+	}
+	mid, ok := AdjustedMidpoint(in.Book, p.MinSize)
+	if !ok {
+		return Estimate{}, ErrNoMidpoint
+	}
+	est := Estimate{Midpoint: mid}
+
+	var bookOne, bookTwo float64
+	for _, l := range in.Book.Bids {
+		bookOne += levelQ(p, mid, l)
+	}
+	for _, l := range in.Book.Asks {
+		bookTwo += levelQ(p, mid, l)
+	}
+
+	for _, q := range in.Quotes {
+		side, price := q.Side, q.Price
+		if in.Book.AssetID != "" && q.TokenID != "" && q.TokenID != in.Book.AssetID {
+			side, price = mirror(side), 1-price
+		}
+		qs := QuoteScore{Quote: q, Spread: spread(price, mid)}
+		switch {
+		case qs.Spread >= p.MaxSpread:
+			qs.Reason = ReasonSpread
+		case q.Size < p.MinSize:
+			qs.Reason = ReasonSize
+		default:
+			qs.Score = OrderScore(p.MaxSpread, qs.Spread)
+			qs.Q = qs.Score * q.Size
+		}
+		if side == "BUY" {
+			est.QOne += qs.Q
+		} else {
+			est.QTwo += qs.Q
+		}
+		est.Quotes = append(est.Quotes, qs)
+	}
+	est.QMin = QMin(est.QOne, est.QTwo, mid)
+	// Every level scores as a one-sided maker. QMin is linear in a single
+	// side, so the per-level sum equals the score of each side's total.
+	market := QMin(bookOne, 0, mid) + QMin(0, bookTwo, mid)
+	if !in.AddToBook {
+		// The quotes already sit in the book as one-sided levels; count
+		// them once, as the maker's two-sided score.
+		market -= QMin(est.QOne, 0, mid) + QMin(0, est.QTwo, mid)
+	}
+	est.MarketQMin = math.Max(market+est.QMin, est.QMin)
+	if est.MarketQMin > 0 {
+		est.Share = est.QMin / est.MarketQMin
+	}
+	est.DailyEarnings = est.Share * p.DailyRate
+	return est, nil
+}
+
+// levelQ scores one book level. Levels that fail to parse do not score.
+func levelQ(p Params, mid float64, l clobtypes.PriceLevel) float64 {
+	if l.Validate() != nil {
+		return 0
+	}
+	size := l.SizeDec().InexactFloat64()
+	if size < p.MinSize {
+		return 0
+	}
+	return OrderScore(p.MaxSpread, spread(l.PriceDec().InexactFloat64(), mid)) * size
+}
+
+func bestLevel(levels []clobtypes.PriceLevel, minSize float64, better func(a, b float64) bool) (float64, bool) {
+	var best float64
+	found := false
+	for _, l := range levels {
+		if l.Validate() != nil || l.SizeDec().InexactFloat64() < minSize {
+			continue
+		}
+		price := l.PriceDec().InexactFloat64()
+		if !found || better(price, best) {
+			best, found = price, true
+		}
+	}
+	return best, found
+}
+
+// spread returns the distance between price and mid, rounded to remove
+// floating point noise from tick arithmetic.
+func spread(price, mid float64) float64 {
+	return math.Round(math.Abs(price-mid)*1e9) / 1e9
+}
+
+func mirror(side string) string {
+	if side == "BUY" {
+		return "SELL"
+	}
+	return "BUY"
+}
@@ -0,0 +1,206 @@
+package rewards
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+)
+
+const defaultPollInterval = 30 * time.Second
+
+// ScoringClient is the part of clob.Client used by Monitor.
+type ScoringClient interface {
+	OrdersScoring(ctx context.Context, req *clobtypes.OrdersScoringRequest) (clobtypes.OrdersScoringResponse, error)
+	OrderBook(ctx context.Context, req *clobtypes.BookRequest) (clobtypes.OrderBookResponse, error)
+}
+
+// AlertKind describes a change in an order's scoring status.
+type AlertKind string
+
+const (
+	// AlertStopped is raised when a tracked order is found not scoring,
+	// either after it scored or on its first check.
+	AlertStopped AlertKind = "stopped"
+	// AlertResumed is raised when an order that was not scoring scores again.
+	AlertResumed AlertKind = "resumed"
+)
+
+// Alert reports a scoring change of a tracked order. For stopped orders,
+// Reason is diagnosed against the order's current book.
+type Alert struct {
+	Kind   AlertKind
+	Quote  Quote
+	Params Params
+	Reason Reason
+	// Midpoint and Spread are zero when the book could not be used.
+	Midpoint float64
+	Spread   float64
+	Time     time.Time
+}
+
+// MonitorConfig controls polling.
+type MonitorConfig struct {
+	// Interval between OrdersScoring polls. Defaults to 30 seconds.
+	Interval time.Duration
+	// OnAlert is called for every alert raised by Run.
+	OnAlert func(Alert)
+	// OnError is called when a poll made by Run fails.
+	OnError func(error)
+}
+
+type trackedOrder struct {
+	quote   Quote
+	params  Params
+	checked bool
+	scoring bool
+}
+
+// Monitor polls OrdersScoring for tracked orders and raises alerts when they
+// stop or resume scoring. It is safe for concurrent use.
+type Monitor struct {
+	client ScoringClient
+	cfg    MonitorConfig
+
+	mu     sync.Mutex
+	orders map[string]*trackedOrder
+}
+
+// NewMonitor creates a Monitor using client.
+func NewMonitor(client ScoringClient, cfg MonitorConfig) *Monitor {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultPollInterval
+	}
+	return &Monitor{client: client, cfg: cfg, orders: make(map[string]*trackedOrder)}
+}
+
+// Track starts monitoring q under the market's reward params. Tracking an
+// order again updates its quote, e.g. after a partial fill, and keeps its
+// scoring state.
+func (m *Monitor) Track(q Quote, p Params) {
+	if q.ID == "" {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if o, ok := m.orders[q.ID]; ok {
+		o.quote, o.params = q, p
+		return
+	}
+	m.orders[q.ID] = &trackedOrder{quote: q, params: p}
+}
+
+// Untrack stops monitoring the given orders.
+func (m *Monitor) Untrack(ids ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range ids {
+		delete(m.orders, id)
+	}
+}
+
+// Poll checks every tracked order once and returns the alerts raised.
+func (m *Monitor) Poll(ctx context.Context) ([]Alert, error) {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.orders))
+	for id := range m.orders {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	sort.Strings(ids)
+
+	resp, err := m.client.OrdersScoring(ctx, &clobtypes.OrdersScoringRequest{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	var alerts []Alert
+	m.mu.Lock()
+	for _, id := range ids {
+		o, ok := m.orders[id]
+		scoring, reported := resp[id]
+		if !ok || !reported {
+			continue
+		}
+		switch {
+		case !scoring && (!o.checked || o.scoring):
+			alerts = append(alerts, Alert{Kind: AlertStopped, Quote: o.quote, Params: o.params, Time: now})
+		case scoring && o.checked && !o.scoring:
+			alerts = append(alerts, Alert{Kind: AlertResumed, Quote: o.quote, Params: o.params, Time: now})
+		}
+		o.checked, o.scoring = true, scoring
+	}
+	m.mu.Unlock()
+
+	books := make(map[string]*clobtypes.OrderBook)
+	for i := range alerts {
+		if alerts[i].Kind == AlertStopped {
+			m.diagnose(ctx, &alerts[i], books)
+		}
+	}
+	return alerts, nil
+}
+
+// diagnose explains why an order stopped scoring. Books are fetched once per
+// token and poll; a failed fetch leaves the reason unknown unless the size
+// alone explains it.
+func (m *Monitor) diagnose(ctx context.Context, a *Alert, books map[string]*clobtypes.OrderBook) {
+	a.Reason = ReasonUnknown
+	if a.Quote.Size < a.Params.MinSize {
+		a.Reason = ReasonSize
+	}
+	book, ok := books[a.Quote.TokenID]
+	if !ok && a.Quote.TokenID != "" {
+		if resp, err := m.client.OrderBook(ctx, &clobtypes.BookRequest{TokenID: a.Quote.TokenID}); err == nil {
+			b := clobtypes.OrderBook(resp)
+			book = &b
+		}
+		books[a.Quote.TokenID] = book
+	}
+	if book == nil {
+		return
+	}
+	mid, ok := AdjustedMidpoint(*book, a.Params.MinSize)
+	if !ok {
+		return
+	}
+	a.Midpoint = mid
+	a.Spread = spread(a.Quote.Price, mid)
+	if a.Reason == ReasonUnknown && a.Params.MaxSpread > 0 && a.Spread >= a.Params.MaxSpread {
+		a.Reason = ReasonSpread
+	}
+}
+
+// Run polls until ctx is done, passing alerts to OnAlert and poll failures to
+// OnError. It returns ctx.Err().
+func (m *Monitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		alerts, err := m.Poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if m.cfg.OnError != nil {
+				m.cfg.OnError(err)
+			}
+		}
+		if m.cfg.OnAlert != nil {
+			for _, a := range alerts {
+				m.cfg.OnAlert(a)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package rewards
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/clob/clobtypes"
+)
+
+var _ ScoringClient = clob.Client(nil)
+
+func near(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func testBook() clobtypes.OrderBook {
+	return clobtypes.OrderBook{
+		AssetID: "yes",
+		Bids: []clobtypes.PriceLevel{
+			{Price: "0.40", Size: "1000"},
+			{Price: "0.48", Size: "200"},
+			{Price: "0.49", Size: "100"},
+			{Price: "0.495", Size: "5"},
+		},
+		Asks: []clobtypes.PriceLevel{
+			{Price: "0.52", Size: "50"},
+			{Price: "0.51", Size: "100"},
+		},
+	}
+}
+
+func TestParamsFromCurrentReward(t *testing.T) {
+	p, err := ParamsFromCurrentReward(clobtypes.CurrentReward{
+		RewardsMaxSpread: "3.5",
+		RewardsMinSize:   "20",
+		RewardsConfig:    []clobtypes.RewardsConfig{{RatePerDay: "10"}, {RatePerDay: "2.5"}},
+	})
+	if err != nil {
+		t.Fatalf("ParamsFromCurrentReward: %v", err)
+	}
+	if !near(p.MaxSpread, 0.035) || p.MinSize != 20 || p.DailyRate != 12.5 {
+		t.Fatalf("unexpected params %+v", p)
+	}
+}
+
+func TestComputeShareAndEarnings(t *testing.T) {
+	est, err := Compute(Input{
+		Params: Params{MaxSpread: 0.03, MinSize: 20, DailyRate: 100},
+		Book:   testBook(),
+		Quotes: []Quote{
+			{ID: "bid", TokenID: "yes", Side: "BUY", Price: 0.49, Size: 100},
+			// A bid on the other outcome mirrors to an ask at 0.51.
+			{ID: "no-bid", TokenID: "no", Side: "BUY", Price: 0.49, Size: 100},
+			{ID: "tiny", TokenID: "yes", Side: "BUY", Price: 0.49, Size: 5},
+			{ID: "wide", TokenID: "yes", Side: "SELL", Price: 0.55, Size: 100},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	// The 5-share bid at 0.495 is below min size and ignored for the midpoint.
+	if !near(est.Midpoint, 0.5) {
+		t.Fatalf("midpoint = %v", est.Midpoint)
+	}
+	if !near(est.QOne, 400.0/9) || !near(est.QTwo, 400.0/9) {
+		t.Fatalf("QOne=%v QTwo=%v", est.QOne, est.QTwo)
+	}
+	// The other makers' levels, 200@0.48 and 50@0.52, score one-sided:
+	// (200*1/9 + 50*1/9)/3 = 250/27. The quotes add their Q_min of 400/9.
+	if !near(est.MarketQMin, 1450.0/27) {
+		t.Fatalf("market QMin = %v", est.MarketQMin)
+	}
+	if !near(est.Share, 24.0/29) || !near(est.DailyEarnings, 100*24.0/29) {
+		t.Fatalf("share=%v earnings=%v", est.Share, est.DailyEarnings)
+	}
+	if est.Quotes[2].Reason != ReasonSize || est.Quotes[3].Reason != ReasonSpread || !est.Quotes[0].Scoring() {
+		t.Fatalf("unexpected quote scores %+v", est.Quotes)
+	}
+}
+
+func TestComputeMarketTotalScoresLevelsOneSided(t *testing.T) {
+	book := clobtypes.OrderBook{
+		AssetID: "yes",
+		Bids:    []clobtypes.PriceLevel{{Price: "0.49", Size: "100"}},
+		Asks:    []clobtypes.PriceLevel{{Price: "0.51", Size: "100"}},
+	}
+	params := Params{MaxSpread: 0.03, MinSize: 20, DailyRate: 100}
+	// Each level scores B = 100*4/9 on its side, so a balanced book of two
+	// one-sided makers totals 2B/3 rather than a single two-sided B.
+	est, err := Compute(Input{Params: params, Book: book})
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !near(est.MarketQMin, 800.0/27) || est.Share != 0 {
+		t.Fatalf("market QMin = %v share = %v", est.MarketQMin, est.Share)
+	}
+
+	est, err = Compute(Input{
+		Params: params,
+		Book:   book,
+		Quotes: []Quote{
+			{ID: "bid", TokenID: "yes", Side: "BUY", Price: 0.49, Size: 100},
+			{ID: "ask", TokenID: "yes", Side: "SELL", Price: 0.51, Size: 100},
+		},
+		AddToBook: true,
+	})
+	if err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	if !near(est.MarketQMin, 2000.0/27) || !near(est.Share, 0.6) {
+		t.Fatalf("market QMin = %v share = %v", est.MarketQMin, est.Share)
+	}
+}
+
+func TestQMinOneSided(t *testing.T) {
+	if got := QMin(90, 0, 0.5); got != 30 {
+		t.Fatalf("one-sided in range = %v", got)
+	}
+	if got := QMin(90, 0, 0.95); got != 0 {
+		t.Fatalf("one-sided out of range = %v", got)
+	}
+}
+
+func TestComputeNoMidpoint(t *testing.T) {
+	_, err := Compute(Input{Params: Params{MaxSpread: 0.03}, Book: clobtypes.OrderBook{Bids: testBook().Bids}})
+	if !errors.Is(err, ErrNoMidpoint) {
+		t.Fatalf("expected ErrNoMidpoint, got %v", err)
+	}
+}
+
+type fakeScoring struct {
+	scoring   clobtypes.OrdersScoringResponse
+	book      clobtypes.OrderBook
+	bookCalls int
+}
+
+func (f *fakeScoring) OrdersScoring(ctx context.Context, req *clobtypes.OrdersScoringRequest) (clobtypes.OrdersScoringResponse, error) {
+	out := clobtypes.OrdersScoringResponse{}
+	for _, id := range req.IDs {
+		if v, ok := f.scoring[id]; ok {
+			out[id] = v
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeScoring) OrderBook(ctx context.Context, req *clobtypes.BookRequest) (clobtypes.OrderBookResponse, error) {
+	f.bookCalls++
+	return clobtypes.OrderBookResponse(f.book), nil
+}
+
+func TestMonitorAlertsOnDrift(t *testing.T) {
+	client := &fakeScoring{
+		scoring: clobtypes.OrdersScoringResponse{"a": true, "b": true},
+		book:    testBook(),
+	}
+	params := Params{MaxSpread: 0.03, MinSize: 20}
+	m := NewMonitor(client, MonitorConfig{})
+	m.Track(Quote{ID: "a", TokenID: "yes", Side: "BUY", Price: 0.46, Size: 100}, params)
+	m.Track(Quote{ID: "b", TokenID: "yes", Side: "BUY", Price: 0.49, Size: 100}, params)
+
+	alerts, err := m.Poll(context.Background())
+	if err != nil || len(alerts) != 0 {
+		t.Fatalf("first poll: alerts=%v err=%v", alerts, err)
+	}
+
+	// a drifted out of the spread; b was partially filled below min size.
+	client.scoring = clobtypes.OrdersScoringResponse{"a": false, "b": false}
+	m.Track(Quote{ID: "b", TokenID: "yes", Side: "BUY", Price: 0.49, Size: 10}, params)
+	alerts, err = m.Poll(context.Background())
+	if err != nil || len(alerts) != 2 {
+		t.Fatalf("second poll: alerts=%v err=%v", alerts, err)
+	}
+	if alerts[0].Kind != AlertStopped || alerts[0].Reason != ReasonSpread || !near(alerts[0].Spread, 0.04) {
+		t.Fatalf("unexpected alert for a: %+v", alerts[0])
+	}
+	if alerts[1].Reason != ReasonSize {
+		t.Fatalf("unexpected alert for b: %+v", alerts[1])
+	}
+	if client.bookCalls != 1 {
+		t.Fatalf("expected one book fetch per token, got %d", client.bookCalls)
+	}
+
+	// No repeat while still not scoring; resumed once it scores again.
+	if alerts, _ = m.Poll(context.Background()); len(alerts) != 0 {
+		t.Fatalf("repeated alerts %v", alerts)
+	}
+	client.scoring["a"] = true
+	alerts, _ = m.Poll(context.Background())
+	if len(alerts) != 1 || alerts[0].Kind != AlertResumed || alerts[0].Quote.ID != "a" {
+		t.Fatalf("expected resume alert, got %v", alerts)
+	}
+}
@@ -18,6 +18,19 @@ type Client interface {
 	SubscribeCommentsStream(ctx context.Context, req *CommentFilter) (*Stream[CommentEvent], error)
 	SubscribeOrdersMatchedStream(ctx context.Context) (*Stream[OrdersMatchedEvent], error)
 	SubscribeRawStream(ctx context.Context, sub *Subscription) (*Stream[RtdsMessage], error)
+	SubscribeActivityTradesStream(ctx context.Context, filter *ActivityFilter) (*Stream[ActivityTradeEvent], error)
+	SubscribeAggOrderbookStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[AggOrderbookEvent], error)
+	SubscribeClobPriceChangeStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobPriceChangeEvent], error)
+	SubscribeClobLastTradePriceStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobLastTradePriceEvent], error)
+	SubscribeClobTickSizeChangeStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobTickSizeChangeEvent], error)
+	SubscribeMarketCreatedStream(ctx context.Context) (*Stream[MarketLifecycleEvent], error)
+	SubscribeMarketResolvedStream(ctx context.Context) (*Stream[MarketLifecycleEvent], error)
+	// SubscribeClobUserOrdersStream and SubscribeClobUserTradesStream require CLOB credentials,
+	// either in the filter or set via Authenticate.
+	SubscribeClobUserOrdersStream(ctx context.Context, filter *ClobUserFilter) (*Stream[ClobUserOrderEvent], error)
+	SubscribeClobUserTradesStream(ctx context.Context, filter *ClobUserFilter) (*Stream[ClobUserTradeEvent], error)
+	SubscribeRfqRequestsStream(ctx context.Context) (*Stream[RfqRequestEvent], error)
+	SubscribeRfqQuotesStream(ctx context.Context) (*Stream[RfqQuoteEvent], error)
 	SubscribeCryptoPrices(ctx context.Context, symbols []string) (<-chan CryptoPriceEvent, error)
 	SubscribeChainlinkPrices(ctx context.Context, feeds []string) (<-chan ChainlinkPriceEvent, error)
 	SubscribeComments(ctx context.Context, req *CommentFilter) (<-chan CommentEvent, error)
@@ -31,5 +44,7 @@ type Client interface {
 	ConnectionState() ConnectionState
 	ConnectionStateStream(ctx context.Context) (*Stream[ConnectionStateEvent], error)
 	SubscriptionCount() int
+	// Stats returns a diagnostics snapshot suitable for health endpoints.
+	Stats() Stats
 	Close() error
 }
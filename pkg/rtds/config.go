@@ -9,19 +9,26 @@ import (
 
 // ClientConfig controls RTDS WebSocket reconnect and heartbeat behavior.
 type ClientConfig struct {
-	Reconnect      bool
-	ReconnectDelay time.Duration
-	ReconnectMax   int
-	PingInterval   time.Duration
+	Reconnect           bool
+	ReconnectDelay      time.Duration
+	ReconnectMaxDelay   time.Duration
+	ReconnectMultiplier float64
+	ReconnectMax        int
+	PingInterval        time.Duration
+	// HeartbeatTimeout forces a reconnect when no frame has arrived for this long.
+	HeartbeatTimeout time.Duration
 }
 
 // DefaultClientConfig returns deterministic defaults without reading environment variables.
 func DefaultClientConfig() ClientConfig {
 	return ClientConfig{
-		Reconnect:      true,
-		ReconnectDelay: 2 * time.Second,
-		ReconnectMax:   5,
-		PingInterval:   5 * time.Second,
+		Reconnect:           true,
+		ReconnectDelay:      2 * time.Second,
+		ReconnectMaxDelay:   30 * time.Second,
+		ReconnectMultiplier: 2.0,
+		ReconnectMax:        5,
+		PingInterval:        5 * time.Second,
+		HeartbeatTimeout:    15 * time.Second,
 	}
 }
 
@@ -36,6 +43,16 @@ func ClientConfigFromEnv() ClientConfig {
 			cfg.ReconnectDelay = time.Duration(ms) * time.Millisecond
 		}
 	}
+	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_RECONNECT_MAX_DELAY_MS")); raw != "" {
+		if ms, err := strconv.Atoi(raw); err == nil && ms > 0 {
+			cfg.ReconnectMaxDelay = time.Duration(ms) * time.Millisecond
+		}
+	}
+	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_RECONNECT_BACKOFF_MULTIPLIER")); raw != "" {
+		if mult, err := strconv.ParseFloat(raw, 64); err == nil && mult > 0 {
+			cfg.ReconnectMultiplier = mult
+		}
+	}
 	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_RECONNECT_MAX")); raw != "" {
 		if max, err := strconv.Atoi(raw); err == nil {
 			cfg.ReconnectMax = max
@@ -46,6 +63,13 @@ func ClientConfigFromEnv() ClientConfig {
 			cfg.PingInterval = time.Duration(ms) * time.Millisecond
 		}
 	}
+	if raw := strings.TrimSpace(os.Getenv("RTDS_WS_HEARTBEAT_TIMEOUT_MS")); raw != "" {
+		if ms, err := strconv.Atoi(raw); err == nil && ms > 0 {
+			cfg.HeartbeatTimeout = time.Duration(ms) * time.Millisecond
+		}
+	} else {
+		cfg.HeartbeatTimeout = cfg.PingInterval * 3
+	}
 	return cfg.normalize()
 }
 
@@ -53,11 +77,23 @@ func (c ClientConfig) normalize() ClientConfig {
 	if c.ReconnectDelay <= 0 {
 		c.ReconnectDelay = 2 * time.Second
 	}
+	if c.ReconnectMaxDelay <= 0 {
+		c.ReconnectMaxDelay = 30 * time.Second
+	}
+	if c.ReconnectMaxDelay < c.ReconnectDelay {
+		c.ReconnectMaxDelay = c.ReconnectDelay
+	}
+	if c.ReconnectMultiplier <= 0 {
+		c.ReconnectMultiplier = 2.0
+	}
 	if c.ReconnectMax < 0 {
 		c.ReconnectMax = 5
 	}
 	if c.PingInterval <= 0 {
 		c.PingInterval = 5 * time.Second
 	}
+	if c.HeartbeatTimeout <= 0 {
+		c.HeartbeatTimeout = c.PingInterval * 3
+	}
 	return c
 }
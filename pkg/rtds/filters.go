@@ -0,0 +1,118 @@
+package rtds
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+)
+
+// ActivityEventFilter matches activity for a single event slug.
+func ActivityEventFilter(eventSlug string) *ActivityFilter {
+	return &ActivityFilter{EventSlug: eventSlug}
+}
+
+// ActivityMarketFilter matches activity for a single market slug.
+func ActivityMarketFilter(marketSlug string) *ActivityFilter {
+	return &ActivityFilter{MarketSlug: marketSlug}
+}
+
+// Filters returns the value to send as Subscription.Filters, or nil for no filter.
+func (f *ActivityFilter) Filters() interface{} {
+	if f == nil {
+		return nil
+	}
+	switch {
+	case f.EventSlug != "":
+		return map[string]string{"event_slug": f.EventSlug}
+	case f.MarketSlug != "":
+		return map[string]string{"market_slug": f.MarketSlug}
+	default:
+		return nil
+	}
+}
+
+// matches applies the filter locally. Subscriptions sharing a topic share the
+// server-side filter of the first subscriber, so every stream re-checks its own.
+func (f *ActivityFilter) matches(ev *ActivityTradeEvent) bool {
+	if f == nil {
+		return true
+	}
+	if f.EventSlug != "" && !strings.EqualFold(f.EventSlug, ev.EventSlug) {
+		return false
+	}
+	if f.MarketSlug != "" && !strings.EqualFold(f.MarketSlug, ev.Slug) {
+		return false
+	}
+	return true
+}
+
+// ClobMarketAssets matches clob_market events for the given asset IDs.
+func ClobMarketAssets(assetIDs ...string) *ClobMarketFilter {
+	return &ClobMarketFilter{AssetIDs: assetIDs}
+}
+
+// Filters returns the value to send as Subscription.Filters, or nil for no filter.
+func (f *ClobMarketFilter) Filters() interface{} {
+	if f == nil || len(f.AssetIDs) == 0 {
+		return nil
+	}
+	return f.AssetIDs
+}
+
+func (f *ClobMarketFilter) assetSet() map[string]struct{} {
+	if f == nil {
+		return nil
+	}
+	return idSet(f.AssetIDs)
+}
+
+// ClobUserAuth builds a clob_user filter with explicit credentials.
+func ClobUserAuth(apiKey *auth.APIKey, markets ...string) *ClobUserFilter {
+	return &ClobUserFilter{Auth: apiKey, Markets: markets}
+}
+
+func idSet(ids []string) map[string]struct{} {
+	if len(ids) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if id = strings.TrimSpace(id); id != "" {
+			set[id] = struct{}{}
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	return set
+}
+
+func inSet(set map[string]struct{}, id string) bool {
+	if set == nil {
+		return true
+	}
+	_, ok := set[id]
+	return ok
+}
+
+// stringList decodes either a single JSON string or an array of strings.
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		if single == "" {
+			*l = nil
+		} else {
+			*l = stringList{single}
+		}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*l = many
+	return nil
+}
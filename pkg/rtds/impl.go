@@ -3,6 +3,7 @@ package rtds
 import (
 	"errors"
 	"net/url"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -20,6 +21,7 @@ const (
 const (
 	connDisconnected int32 = iota
 	connConnected
+	connReconnecting
 )
 
 // Use unified error definitions from pkg/errors
@@ -43,10 +45,14 @@ type clientImpl struct {
 	closeOnce sync.Once
 	closing   atomic.Bool
 
-	reconnect      bool
-	reconnectDelay time.Duration
-	reconnectMax   int
-	pingInterval   time.Duration
+	reconnect           bool
+	reconnectDelay      time.Duration
+	reconnectMaxDelay   time.Duration
+	reconnectMultiplier float64
+	reconnectMax        int
+	pingInterval        time.Duration
+	heartbeatTimeout    time.Duration
+	lastSeen            atomic.Int64 // unix nanos of the last inbound frame
 
 	stateMu     sync.Mutex
 	stateSubs   map[string]*stateSubscription
@@ -61,6 +67,8 @@ type clientImpl struct {
 
 	authMu sync.RWMutex
 	auth   *auth.APIKey
+
+	stats clientStats
 }
 
 func NewClient(url string) (Client, error) {
@@ -78,18 +86,21 @@ func NewClientWithConfig(url string, cfg ClientConfig) (Client, error) {
 	cfg = cfg.normalize()
 
 	c := &clientImpl{
-		url:            url,
-		done:           make(chan struct{}),
-		connReady:      make(chan struct{}),
-		stateSubs:      make(map[string]*stateSubscription),
-		subRefs:        make(map[string]int),
-		subDetails:     make(map[string]Subscription),
-		subs:           make(map[string]*subscriptionEntry),
-		subsByKey:      make(map[string]map[string]*subscriptionEntry),
-		reconnect:      cfg.Reconnect,
-		reconnectDelay: cfg.ReconnectDelay,
-		reconnectMax:   cfg.ReconnectMax,
-		pingInterval:   cfg.PingInterval,
+		url:                 url,
+		done:                make(chan struct{}),
+		connReady:           make(chan struct{}),
+		stateSubs:           make(map[string]*stateSubscription),
+		subRefs:             make(map[string]int),
+		subDetails:          make(map[string]Subscription),
+		subs:                make(map[string]*subscriptionEntry),
+		subsByKey:           make(map[string]map[string]*subscriptionEntry),
+		reconnect:           cfg.Reconnect,
+		reconnectDelay:      cfg.ReconnectDelay,
+		reconnectMaxDelay:   cfg.ReconnectMaxDelay,
+		reconnectMultiplier: cfg.ReconnectMultiplier,
+		reconnectMax:        cfg.ReconnectMax,
+		pingInterval:        cfg.PingInterval,
+		heartbeatTimeout:    cfg.HeartbeatTimeout,
 	}
 
 	go c.run()
@@ -138,6 +149,7 @@ func (c *clientImpl) connect() error {
 	c.mu.Lock()
 	c.conn = conn
 	c.mu.Unlock()
+	c.lastSeen.Store(time.Now().UnixNano())
 	c.setState(ConnectionConnected)
 	c.connOnce.Do(func() { close(c.connReady) })
 	return nil
@@ -150,32 +162,68 @@ func (c *clientImpl) run() {
 			c.signalDone()
 			return
 		}
-		if err := c.connect(); err != nil {
-			if !c.shouldReconnect(attempts) {
-				c.signalDone()
-				return
+		if err := c.connect(); err == nil {
+			reconnected := attempts > 0
+			attempts = 0
+			if c.resubscribeAll(reconnected) == nil && reconnected {
+				c.stats.recordReconnect()
 			}
-			attempts++
-			time.Sleep(c.reconnectDelay)
-			continue
-		}
-
-		attempts = 0
-		c.resubscribeAll()
 
-		if err := c.readLoop(); err != nil {
+			err = c.readLoop()
 			if c.closing.Load() {
 				c.signalDone()
 				return
 			}
-			if !c.shouldReconnect(attempts) {
-				c.signalDone()
-				return
+			if c.reconnect {
+				c.stats.recordDisconnect(err)
 			}
-			attempts++
-			time.Sleep(c.reconnectDelay)
-			continue
 		}
+
+		if !c.shouldReconnect(attempts) {
+			c.signalDone()
+			return
+		}
+		attempts++
+		c.setStateAttempt(ConnectionReconnecting, attempts)
+		if !c.sleep(c.backoffDelay(attempts)) {
+			c.signalDone()
+			return
+		}
+	}
+}
+
+// backoffDelay returns the wait before reconnect attempt n (1-based).
+func (c *clientImpl) backoffDelay(attempt int) time.Duration {
+	delay := c.reconnectDelay
+	if delay <= 0 {
+		delay = 2 * time.Second
+	}
+	maxDelay := c.reconnectMaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 30 * time.Second
+	}
+	multiplier := c.reconnectMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay = time.Duration(float64(delay) * multiplier)
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// sleep waits for d or until the client is closed; it reports whether the full duration elapsed.
+func (c *clientImpl) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return !c.closing.Load()
+	case <-c.done:
+		return false
 	}
 }
 
@@ -201,12 +249,19 @@ func (c *clientImpl) pingLoop() {
 		case <-c.done:
 			return
 		case <-ticker.C:
+			if c.heartbeatExpired(time.Now()) {
+				logger.Warn("rtds heartbeat timeout (no frames for %s)", c.heartbeatTimeout)
+				c.stats.markDisconnect("heartbeat timeout")
+				c.closeConn()
+				continue
+			}
 			c.mu.Lock()
 			if c.conn == nil {
 				c.mu.Unlock()
 				continue
 			}
 			// RTDS expects a "PING" text message
+			c.stats.recordPing(time.Now())
 			err := c.conn.WriteMessage(websocket.TextMessage, []byte("PING"))
 			c.mu.Unlock()
 			if err != nil {
@@ -217,6 +272,18 @@ func (c *clientImpl) pingLoop() {
 	}
 }
 
+// heartbeatExpired reports whether the live connection has been silent for longer than the heartbeat timeout.
+func (c *clientImpl) heartbeatExpired(now time.Time) bool {
+	if c.heartbeatTimeout <= 0 || c.ConnectionState() != ConnectionConnected {
+		return false
+	}
+	last := c.lastSeen.Load()
+	if last == 0 {
+		return false
+	}
+	return now.Sub(time.Unix(0, last)) > c.heartbeatTimeout
+}
+
 func (c *clientImpl) readLoop() error {
 	c.mu.Lock()
 	conn := c.conn
@@ -231,6 +298,13 @@ func (c *clientImpl) readLoop() error {
 			c.setState(ConnectionDisconnected)
 			return err
 		}
+		receivedAt := time.Now()
+		c.lastSeen.Store(receivedAt.UnixNano())
+		c.stats.recordFrame(len(message), receivedAt)
+		if isPong(message) {
+			c.stats.recordPong(receivedAt)
+			continue
+		}
 
 		msgs, err := parseMessages(message)
 		if err != nil {
@@ -238,11 +312,16 @@ func (c *clientImpl) readLoop() error {
 		}
 
 		for _, msg := range msgs {
+			c.stats.recordMessage(msg)
 			c.dispatch(msg)
 		}
 	}
 }
 
+func isPong(message []byte) bool {
+	return strings.EqualFold(strings.TrimSpace(string(message)), "PONG")
+}
+
 func (c *clientImpl) dispatch(msg RtdsMessage) {
 	c.subMu.Lock()
 	subs := make([]*subscriptionEntry, 0, len(c.subs))
@@ -259,10 +338,14 @@ func (c *clientImpl) dispatch(msg RtdsMessage) {
 }
 
 func (c *clientImpl) ConnectionState() ConnectionState {
-	if atomic.LoadInt32(&c.state) == connConnected {
+	switch atomic.LoadInt32(&c.state) {
+	case connConnected:
 		return ConnectionConnected
+	case connReconnecting:
+		return ConnectionReconnecting
+	default:
+		return ConnectionDisconnected
 	}
-	return ConnectionDisconnected
 }
 
 func (c *clientImpl) SubscriptionCount() int {
@@ -315,17 +398,31 @@ func (c *clientImpl) sendSubscriptions(action SubscriptionAction, subs []Subscri
 	})
 }
 
-func (c *clientImpl) resubscribeAll() {
+// resubscribeAll replays active subscriptions on a fresh connection. After a
+// reconnect the outcome is published on the connection state streams.
+func (c *clientImpl) resubscribeAll(reconnected bool) error {
 	c.subMu.Lock()
 	subs := make([]Subscription, 0, len(c.subDetails))
 	for _, sub := range c.subDetails {
 		subs = append(subs, sub)
 	}
 	c.subMu.Unlock()
-	if len(subs) == 0 {
-		return
-	}
-	if err := c.sendSubscriptions(SubscribeAction, subs); err != nil {
+	err := c.sendSubscriptions(SubscribeAction, subs)
+	if err != nil {
 		logger.Error("rtds resubscribe failed: %v", err)
 	}
+	if !reconnected {
+		return err
+	}
+	event := ConnectionStateEvent{
+		State:    ConnectionConnected,
+		Recorded: time.Now().UnixMilli(),
+	}
+	if err != nil {
+		event.ResubscribeError = err.Error()
+	} else {
+		event.Resubscribed = len(subs)
+	}
+	c.publishState(event)
+	return err
 }
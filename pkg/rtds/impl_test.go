@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 	"github.com/gorilla/websocket"
 )
 
@@ -464,7 +465,7 @@ func TestStream_Close_Nil(t *testing.T) {
 }
 
 func TestStream_Close_NilCloseF(t *testing.T) {
-	s := &Stream[int]{closeF: nil}
+	s := stream.New[int](nil, nil, nil)
 	if err := s.Close(); err != nil {
 		t.Fatalf("nil closeF should not error: %v", err)
 	}
@@ -472,7 +473,7 @@ func TestStream_Close_NilCloseF(t *testing.T) {
 
 func TestStream_Close_Normal(t *testing.T) {
 	called := false
-	s := &Stream[int]{closeF: func() error { called = true; return nil }}
+	s := stream.New[int](nil, nil, func() error { called = true; return nil })
 	if err := s.Close(); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -2,8 +2,11 @@ package rtds
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"strings"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 )
 
 func symbolSet(symbols []string) map[string]struct{} {
@@ -24,48 +27,17 @@ func symbolSet(symbols []string) map[string]struct{} {
 	return set
 }
 
-func mapStream[T any](src *Stream[RtdsMessage], topic, msgType string, mapFn func(RtdsMessage) (T, bool)) *Stream[T] {
-	outC := make(chan T, defaultStreamBuffer)
-	errC := make(chan error, defaultErrBuffer)
-
-	go func() {
-		defer close(outC)
-		defer close(errC)
-		for {
-			select {
-			case msg, ok := <-src.C:
-				if !ok {
-					return
-				}
-				mapped, ok := mapFn(msg)
-				if !ok {
-					continue
-				}
-				select {
-				case outC <- mapped:
-				default:
-					select {
-					case errC <- LaggedError{Count: 1, Topic: topic, MsgType: msgType}:
-					default:
-					}
-				}
-			case err, ok := <-src.Err:
-				if !ok {
-					return
-				}
-				select {
-				case errC <- err:
-				default:
-				}
-			}
-		}
-	}()
-
-	return &Stream[T]{
-		C:      outC,
-		Err:    errC,
-		closeF: src.Close,
-	}
+// mapStream decodes raw messages of a subscription entry into typed events.
+// Drops at this stage count against the entry and are reported as LaggedError.
+func mapStream[T any](src *Stream[RtdsMessage], entry *subscriptionEntry, mapFn func(RtdsMessage) (T, bool)) *Stream[T] {
+	return stream.FilterMap(context.Background(), src, mapFn,
+		stream.WithBuffer(defaultStreamBuffer),
+		stream.WithErrBuffer(defaultErrBuffer),
+		stream.WithLagError(func(count int) error {
+			entry.dropped.Add(uint64(count))
+			return LaggedError{Count: count, Topic: entry.topic, MsgType: entry.msgType}
+		}),
+	)
 }
 
 func parseMessages(message []byte) ([]RtdsMessage, error) {
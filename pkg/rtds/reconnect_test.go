@@ -0,0 +1,189 @@
+package rtds
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	c := newTestClient()
+	c.reconnectDelay = 100 * time.Millisecond
+	c.reconnectMaxDelay = time.Second
+	c.reconnectMultiplier = 3
+
+	want := []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second, time.Second}
+	for i, expected := range want {
+		if got := c.backoffDelay(i + 1); got != expected {
+			t.Fatalf("attempt %d: expected %s, got %s", i+1, expected, got)
+		}
+	}
+}
+
+func TestClientConfigNormalizeBackoff(t *testing.T) {
+	cfg := ClientConfig{ReconnectDelay: time.Minute, PingInterval: time.Second}.normalize()
+	if cfg.ReconnectMultiplier != 2 {
+		t.Fatalf("expected default multiplier, got %v", cfg.ReconnectMultiplier)
+	}
+	if cfg.ReconnectMaxDelay != time.Minute {
+		t.Fatalf("expected max delay raised to base delay, got %s", cfg.ReconnectMaxDelay)
+	}
+	if cfg.HeartbeatTimeout != 3*time.Second {
+		t.Fatalf("expected heartbeat timeout derived from ping interval, got %s", cfg.HeartbeatTimeout)
+	}
+}
+
+func TestHeartbeatExpired(t *testing.T) {
+	c := newTestClient()
+	c.heartbeatTimeout = time.Second
+	now := time.Now()
+	c.lastSeen.Store(now.Add(-2 * time.Second).UnixNano())
+	if c.heartbeatExpired(now) {
+		t.Fatal("disconnected client should not report heartbeat expiry")
+	}
+	c.setState(ConnectionConnected)
+	if !c.heartbeatExpired(now) {
+		t.Fatal("expected heartbeat expiry after silence")
+	}
+	c.lastSeen.Store(now.UnixNano())
+	if c.heartbeatExpired(now) {
+		t.Fatal("fresh frame should keep heartbeat alive")
+	}
+}
+
+// flakyServer drops the first connection once it has seen a subscription and keeps later ones open.
+func flakyServer(t *testing.T, conns *atomic.Int32, subscribes chan<- string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		n := conns.Add(1)
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if strings.Contains(string(msg), "subscribe") {
+				select {
+				case subscribes <- string(msg):
+				default:
+				}
+				if n == 1 {
+					return
+				}
+			}
+		}
+	}))
+}
+
+func TestReconnectStateAndResubscribe(t *testing.T) {
+	var conns atomic.Int32
+	subscribes := make(chan string, 10)
+	srv := flakyServer(t, &conns, subscribes)
+	defer srv.Close()
+
+	cfg := DefaultClientConfig()
+	cfg.ReconnectDelay = 20 * time.Millisecond
+	cfg.ReconnectMaxDelay = 50 * time.Millisecond
+	cfg.ReconnectMax = 3
+	client, err := NewClientWithConfig("ws"+strings.TrimPrefix(srv.URL, "http"), cfg)
+	if err != nil {
+		t.Fatalf("NewClientWithConfig failed: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	states, err := client.ConnectionStateStream(ctx)
+	if err != nil {
+		t.Fatalf("ConnectionStateStream failed: %v", err)
+	}
+	stream, err := client.SubscribeCryptoPricesStream(ctx, []string{"btcusdt"})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	var sawReconnecting bool
+	for {
+		select {
+		case ev := <-states.C:
+			if ev.State == ConnectionReconnecting {
+				if ev.Attempt != 1 {
+					t.Fatalf("expected first reconnect attempt, got %d", ev.Attempt)
+				}
+				sawReconnecting = true
+			}
+			if ev.State == ConnectionConnected && ev.Resubscribed > 0 {
+				if !sawReconnecting {
+					t.Fatal("resubscribe confirmed before reconnecting state")
+				}
+				if ev.Resubscribed != 1 || ev.ResubscribeError != "" {
+					t.Fatalf("unexpected resubscribe event: %+v", ev)
+				}
+				for i := 0; i < 2; i++ {
+					select {
+					case <-subscribes:
+					case <-ctx.Done():
+						t.Fatalf("expected subscription replayed to server, saw %d", i)
+					}
+				}
+				if stats := client.Stats(); stats.Reconnects != 1 {
+					t.Fatalf("expected one reconnect in stats, got %d", stats.Reconnects)
+				}
+				return
+			}
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for resubscribe confirmation (connections=%d)", conns.Load())
+		}
+	}
+}
+
+func TestHeartbeatTimeoutForcesReconnect(t *testing.T) {
+	var conns atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conns.Add(1)
+		// Swallow pings without ever answering.
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	cfg := DefaultClientConfig()
+	cfg.PingInterval = 20 * time.Millisecond
+	cfg.HeartbeatTimeout = 60 * time.Millisecond
+	cfg.ReconnectDelay = 10 * time.Millisecond
+	cfg.ReconnectMax = 0
+	client, err := NewClientWithConfig("ws"+strings.TrimPrefix(srv.URL, "http"), cfg)
+	if err != nil {
+		t.Fatalf("NewClientWithConfig failed: %v", err)
+	}
+	defer client.Close()
+
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		if conns.Load() >= 2 {
+			if reason := client.Stats().LastReconnectReason; reason != "heartbeat timeout" {
+				t.Fatalf("expected heartbeat timeout reason, got %q", reason)
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("expected reconnect after heartbeat timeout, connections=%d", conns.Load())
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 )
 
 const (
@@ -17,17 +19,17 @@ type stateSubscription struct {
 	id        string
 	ch        chan ConnectionStateEvent
 	errCh     chan error
+	mu        sync.Mutex // serializes sends with close
 	closed    atomic.Bool
 	closeOnce sync.Once
 }
 
 func (s *stateSubscription) trySend(event ConnectionStateEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.closed.Load() {
 		return
 	}
-	defer func() {
-		_ = recover()
-	}()
 	select {
 	case s.ch <- event:
 		return
@@ -47,6 +49,8 @@ func (s *stateSubscription) notifyLag(count int) {
 }
 
 func (s *stateSubscription) close() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.closed.Swap(true) {
 		return false
 	}
@@ -69,24 +73,20 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 	c.stateSubs[entry.id] = entry
 	c.stateMu.Unlock()
 
-	stream := &Stream[ConnectionStateEvent]{
-		C:   entry.ch,
-		Err: entry.errCh,
-		closeF: func() error {
-			if entry.close() {
-				c.stateMu.Lock()
-				delete(c.stateSubs, entry.id)
-				c.stateMu.Unlock()
-			}
-			return nil
-		},
-	}
+	out := stream.New(entry.ch, entry.errCh, func() error {
+		if entry.close() {
+			c.stateMu.Lock()
+			delete(c.stateSubs, entry.id)
+			c.stateMu.Unlock()
+		}
+		return nil
+	})
 
 	if ctx != nil {
 		if done := ctx.Done(); done != nil {
 			go func() {
 				<-done
-				_ = stream.Close()
+				_ = out.Close()
 			}()
 		}
 	}
@@ -95,21 +95,32 @@ func (c *clientImpl) ConnectionStateStream(ctx context.Context) (*Stream[Connect
 		State:    c.ConnectionState(),
 		Recorded: time.Now().UnixMilli(),
 	})
-	return stream, nil
+	return out, nil
 }
 
 func (c *clientImpl) setState(state ConnectionState) {
+	c.setStateAttempt(state, 0)
+}
+
+// setStateAttempt records a transition; attempt is the 1-based reconnect attempt for ConnectionReconnecting.
+func (c *clientImpl) setStateAttempt(state ConnectionState, attempt int) {
 	switch state {
 	case ConnectionConnected:
 		atomic.StoreInt32(&c.state, connConnected)
+	case ConnectionReconnecting:
+		atomic.StoreInt32(&c.state, connReconnecting)
 	default:
 		atomic.StoreInt32(&c.state, connDisconnected)
 	}
 
-	event := ConnectionStateEvent{
+	c.publishState(ConnectionStateEvent{
 		State:    state,
+		Attempt:  attempt,
 		Recorded: time.Now().UnixMilli(),
-	}
+	})
+}
+
+func (c *clientImpl) publishState(event ConnectionStateEvent) {
 	c.stateMu.Lock()
 	subs := make([]*stateSubscription, 0, len(c.stateSubs))
 	for _, sub := range c.stateSubs {
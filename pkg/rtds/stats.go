@@ -0,0 +1,163 @@
+package rtds
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Stats is a point-in-time snapshot of the client's diagnostics. It is
+// JSON-encodable so it can be served directly from a health endpoint.
+type Stats struct {
+	State               ConnectionState     `json:"state"`
+	MessagesReceived    uint64              `json:"messages_received"`
+	MessagesByTopic     map[string]uint64   `json:"messages_by_topic"`
+	BytesReceived       uint64              `json:"bytes_received"`
+	PongsReceived       uint64              `json:"pongs_received"`
+	LastMessageAt       time.Time           `json:"last_message_at"`
+	LastPongRTT         time.Duration       `json:"last_pong_rtt_ns"`
+	Reconnects          int                 `json:"reconnects"`
+	LastReconnectAt     time.Time           `json:"last_reconnect_at"`
+	LastReconnectReason string              `json:"last_reconnect_reason,omitempty"`
+	ActiveTopics        int                 `json:"active_topics"`
+	TopicRefs           int                 `json:"topic_refs"`
+	Subscriptions       []SubscriptionStats `json:"subscriptions"`
+}
+
+// SubscriptionStats reports per-subscription delivery counters.
+type SubscriptionStats struct {
+	ID      string `json:"id"`
+	Topic   string `json:"topic"`
+	MsgType string `json:"type"`
+	Dropped uint64 `json:"dropped"`
+}
+
+// clientStats accumulates connection counters. The zero value is ready to use.
+type clientStats struct {
+	mu            sync.Mutex
+	messages      uint64
+	byTopic       map[string]uint64
+	bytes         uint64
+	pongs         uint64
+	lastMessage   time.Time
+	lastPing      time.Time
+	lastRTT       time.Duration
+	reconnects    int
+	lastReconnect time.Time
+	lastReason    string
+	dropReason    string
+	pendingReason string
+}
+
+func (s *clientStats) recordFrame(size int, at time.Time) {
+	s.mu.Lock()
+	s.messages++
+	s.bytes += uint64(size)
+	s.lastMessage = at
+	s.mu.Unlock()
+}
+
+func (s *clientStats) recordMessage(msg RtdsMessage) {
+	key := msg.Topic + "/" + msg.MsgType
+	s.mu.Lock()
+	if s.byTopic == nil {
+		s.byTopic = make(map[string]uint64)
+	}
+	s.byTopic[key]++
+	s.mu.Unlock()
+}
+
+func (s *clientStats) recordPing(at time.Time) {
+	s.mu.Lock()
+	s.lastPing = at
+	s.mu.Unlock()
+}
+
+func (s *clientStats) recordPong(at time.Time) {
+	s.mu.Lock()
+	s.pongs++
+	if !s.lastPing.IsZero() {
+		s.lastRTT = at.Sub(s.lastPing)
+	}
+	s.mu.Unlock()
+}
+
+// markDisconnect remembers why the client tore the connection down itself, so
+// the resulting read error is attributed to the real cause.
+func (s *clientStats) markDisconnect(reason string) {
+	s.mu.Lock()
+	s.pendingReason = reason
+	s.mu.Unlock()
+}
+
+// recordDisconnect notes why the connection dropped; the reason is reported
+// once the reconnect succeeds.
+func (s *clientStats) recordDisconnect(cause error) {
+	s.mu.Lock()
+	switch {
+	case s.pendingReason != "":
+		s.dropReason = s.pendingReason
+	case cause != nil:
+		s.dropReason = cause.Error()
+	default:
+		s.dropReason = "unknown"
+	}
+	s.pendingReason = ""
+	s.mu.Unlock()
+}
+
+// recordReconnect counts a reconnect once the new connection is dialed and
+// its subscriptions restored.
+func (s *clientStats) recordReconnect() {
+	s.mu.Lock()
+	s.reconnects++
+	s.lastReconnect = time.Now()
+	s.lastReason = s.dropReason
+	if s.lastReason == "" {
+		s.lastReason = "unknown"
+	}
+	s.dropReason = ""
+	s.mu.Unlock()
+}
+
+func (c *clientImpl) Stats() Stats {
+	out := Stats{State: c.ConnectionState()}
+
+	c.stats.mu.Lock()
+	out.MessagesReceived = c.stats.messages
+	out.BytesReceived = c.stats.bytes
+	out.PongsReceived = c.stats.pongs
+	out.LastMessageAt = c.stats.lastMessage
+	out.LastPongRTT = c.stats.lastRTT
+	out.Reconnects = c.stats.reconnects
+	out.LastReconnectAt = c.stats.lastReconnect
+	out.LastReconnectReason = c.stats.lastReason
+	out.MessagesByTopic = make(map[string]uint64, len(c.stats.byTopic))
+	for k, v := range c.stats.byTopic {
+		out.MessagesByTopic[k] = v
+	}
+	c.stats.mu.Unlock()
+
+	c.subMu.Lock()
+	for _, count := range c.subRefs {
+		if count > 0 {
+			out.ActiveTopics++
+			out.TopicRefs += count
+		}
+	}
+	out.Subscriptions = make([]SubscriptionStats, 0, len(c.subs))
+	for _, sub := range c.subs {
+		out.Subscriptions = append(out.Subscriptions, SubscriptionStats{
+			ID:      sub.id,
+			Topic:   sub.topic,
+			MsgType: sub.msgType,
+			Dropped: sub.dropped.Load(),
+		})
+	}
+	c.subMu.Unlock()
+
+	sort.Slice(out.Subscriptions, func(i, j int) bool {
+		return out.Subscriptions[i].ID < out.Subscriptions[j].ID
+	})
+	return out
+}
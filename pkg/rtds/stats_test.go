@@ -0,0 +1,93 @@
+package rtds
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
+)
+
+func TestStats_CountsMessagesAndDrops(t *testing.T) {
+	c := newTestClient()
+	c.subRefs["crypto_prices|update"] = 2
+	entry := &subscriptionEntry{
+		id: "crypto_prices|update#1", key: "crypto_prices|update", topic: "crypto_prices", msgType: "update",
+		ch: make(chan RtdsMessage, 1), errCh: make(chan error, 5),
+	}
+	c.subs[entry.id] = entry
+
+	now := time.Now()
+	msg := RtdsMessage{Topic: "crypto_prices", MsgType: "update"}
+	for i := 0; i < 3; i++ {
+		c.stats.recordFrame(50, now)
+		c.stats.recordMessage(msg)
+		c.dispatch(msg)
+	}
+
+	stats := c.Stats()
+	if stats.MessagesReceived != 3 || stats.BytesReceived != 150 {
+		t.Fatalf("unexpected frame counters: %+v", stats)
+	}
+	if stats.MessagesByTopic["crypto_prices/update"] != 3 {
+		t.Fatalf("unexpected per-topic counts: %+v", stats.MessagesByTopic)
+	}
+	if stats.ActiveTopics != 1 || stats.TopicRefs != 2 {
+		t.Fatalf("expected 1 topic / 2 refs, got %d / %d", stats.ActiveTopics, stats.TopicRefs)
+	}
+	if len(stats.Subscriptions) != 1 || stats.Subscriptions[0].Dropped != 2 {
+		t.Fatalf("expected two dropped messages, got %+v", stats.Subscriptions)
+	}
+}
+
+func TestStats_MappedStreamDropsCounted(t *testing.T) {
+	c := newTestClient()
+	entry := &subscriptionEntry{
+		id: "crypto_prices|update#1", key: "crypto_prices|update", topic: "crypto_prices", msgType: "update",
+		ch: make(chan RtdsMessage, defaultStreamBuffer), errCh: make(chan error, defaultErrBuffer),
+	}
+	c.subs[entry.id] = entry
+	raw := stream.New(entry.ch, entry.errCh, nil)
+	mapped := mapStream(raw, entry, func(msg RtdsMessage) (RtdsMessage, bool) { return msg, true })
+	defer mapped.Close()
+
+	msg := RtdsMessage{Topic: "crypto_prices", MsgType: "update"}
+	for i := 0; i < defaultStreamBuffer+5; i++ {
+		c.dispatch(msg)
+	}
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if subs := c.Stats().Subscriptions; len(subs) == 1 && subs[0].Dropped > 0 {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("expected drops in mapped stream to be counted: %+v", c.Stats().Subscriptions)
+}
+
+func TestStats_PongAndReconnect(t *testing.T) {
+	c := newTestClient()
+	ping := time.Now()
+	c.stats.recordPing(ping)
+	c.stats.recordPong(ping.Add(40 * time.Millisecond))
+	c.stats.recordDisconnect(errors.New("EOF"))
+	if n := c.Stats().Reconnects; n != 0 {
+		t.Fatalf("expected a drop without a successful reconnect to be uncounted, got %d", n)
+	}
+	c.stats.recordReconnect()
+
+	stats := c.Stats()
+	if stats.PongsReceived != 1 || stats.LastPongRTT != 40*time.Millisecond {
+		t.Fatalf("unexpected pong stats: %+v", stats)
+	}
+	if stats.Reconnects != 1 || stats.LastReconnectReason != "EOF" || stats.LastReconnectAt.IsZero() {
+		t.Fatalf("unexpected reconnect stats: %+v", stats)
+	}
+	if !isPong([]byte(" PONG\n")) || isPong([]byte(`{"topic":"x"}`)) {
+		t.Fatalf("isPong misclassified frames")
+	}
+	if _, err := json.Marshal(stats); err != nil {
+		t.Fatalf("stats not JSON encodable: %v", err)
+	}
+}
@@ -1,21 +1,14 @@
 package rtds
 
-import "fmt"
+import (
+	"fmt"
 
-// Stream delivers messages and async errors for a subscription.
-type Stream[T any] struct {
-	C      <-chan T
-	Err    <-chan error
-	closeF func() error
-}
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
+)
 
-// Close stops the subscription and closes the stream.
-func (s *Stream[T]) Close() error {
-	if s == nil || s.closeF == nil {
-		return nil
-	}
-	return s.closeF()
-}
+// Stream delivers messages and async errors for a subscription. It is the
+// shared stream type, so the operators in package stream apply to it directly.
+type Stream[T any] = stream.Stream[T]
 
 // LaggedError indicates the subscriber missed messages due to backpressure.
 type LaggedError struct {
@@ -30,3 +23,6 @@ func (e LaggedError) Error() string {
 	}
 	return fmt.Sprintf("rtds subscription lagged, missed %d messages (topic=%s type=%s)", e.Count, e.Topic, e.MsgType)
 }
+
+// LagCount returns the number of messages missed, satisfying stream.Lagged.
+func (e LaggedError) LagCount() int { return e.Count }
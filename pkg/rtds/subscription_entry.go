@@ -13,8 +13,10 @@ type subscriptionEntry struct {
 	filter    func(RtdsMessage) bool
 	ch        chan RtdsMessage
 	errCh     chan error
+	mu        sync.Mutex // serializes sends with close
 	closed    atomic.Bool
 	closeOnce sync.Once
+	dropped   atomic.Uint64 // messages discarded because the subscriber fell behind
 }
 
 func (s *subscriptionEntry) matches(msg RtdsMessage) bool {
@@ -31,16 +33,16 @@ func (s *subscriptionEntry) matches(msg RtdsMessage) bool {
 }
 
 func (s *subscriptionEntry) trySend(msg RtdsMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.closed.Load() {
 		return
 	}
-	defer func() {
-		_ = recover()
-	}()
 	select {
 	case s.ch <- msg:
 		return
 	default:
+		s.dropped.Add(1)
 		s.notifyLag(1)
 	}
 }
@@ -57,6 +59,8 @@ func (s *subscriptionEntry) notifyLag(count int) {
 }
 
 func (s *subscriptionEntry) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.closed.Swap(true) {
 		return
 	}
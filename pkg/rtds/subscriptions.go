@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/stream"
 )
 
 func (c *clientImpl) SubscribeCryptoPricesStream(ctx context.Context, symbols []string) (*Stream[CryptoPriceEvent], error) {
@@ -14,12 +16,12 @@ func (c *clientImpl) SubscribeCryptoPricesStream(ctx context.Context, symbols []
 	if len(symbols) > 0 {
 		sub.Filters = symbols
 	}
-	rawStream, err := c.subscribeRawStream(sub, nil)
+	entry, rawStream, err := c.subscribeEntryStream(sub, nil)
 	if err != nil {
 		return nil, err
 	}
 	set := symbolSet(symbols)
-	return mapStream(rawStream, sub.Topic, sub.MsgType, func(msg RtdsMessage) (CryptoPriceEvent, bool) {
+	return mapStream(rawStream, entry, func(msg RtdsMessage) (CryptoPriceEvent, bool) {
 		var payload CryptoPriceEvent
 		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
 			return CryptoPriceEvent{}, false
@@ -47,12 +49,12 @@ func (c *clientImpl) SubscribeChainlinkPricesStream(ctx context.Context, feeds [
 			sub.Filters = string(filterBytes)
 		}
 	}
-	rawStream, err := c.subscribeRawStream(sub, nil)
+	entry, rawStream, err := c.subscribeEntryStream(sub, nil)
 	if err != nil {
 		return nil, err
 	}
 	set := symbolSet(feeds)
-	return mapStream(rawStream, sub.Topic, sub.MsgType, func(msg RtdsMessage) (ChainlinkPriceEvent, bool) {
+	return mapStream(rawStream, entry, func(msg RtdsMessage) (ChainlinkPriceEvent, bool) {
 		var payload ChainlinkPriceEvent
 		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
 			return ChainlinkPriceEvent{}, false
@@ -102,11 +104,11 @@ func (c *clientImpl) SubscribeCommentsStream(ctx context.Context, req *CommentFi
 			}
 		}
 	}
-	rawStream, err := c.subscribeRawStream(sub, nil)
+	entry, rawStream, err := c.subscribeEntryStream(sub, nil)
 	if err != nil {
 		return nil, err
 	}
-	return mapStream(rawStream, sub.Topic, sub.MsgType, func(msg RtdsMessage) (CommentEvent, bool) {
+	return mapStream(rawStream, entry, func(msg RtdsMessage) (CommentEvent, bool) {
 		var payload CommentEvent
 		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
 			return CommentEvent{}, false
@@ -122,11 +124,11 @@ func (c *clientImpl) SubscribeCommentsStream(ctx context.Context, req *CommentFi
 
 func (c *clientImpl) SubscribeOrdersMatchedStream(ctx context.Context) (*Stream[OrdersMatchedEvent], error) {
 	sub := Subscription{Topic: string(Activity), MsgType: "orders_matched"}
-	rawStream, err := c.subscribeRawStream(sub, nil)
+	entry, rawStream, err := c.subscribeEntryStream(sub, nil)
 	if err != nil {
 		return nil, err
 	}
-	return mapStream(rawStream, sub.Topic, sub.MsgType, func(msg RtdsMessage) (OrdersMatchedEvent, bool) {
+	return mapStream(rawStream, entry, func(msg RtdsMessage) (OrdersMatchedEvent, bool) {
 		var payload OrdersMatchedEvent
 		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
 			return OrdersMatchedEvent{}, false
@@ -223,18 +225,21 @@ func subscriptionKey(topic, msgType string) string {
 }
 
 func (c *clientImpl) subscribeRawStream(sub Subscription, filter func(RtdsMessage) bool) (*Stream[RtdsMessage], error) {
+	_, raw, err := c.subscribeEntryStream(sub, filter)
+	return raw, err
+}
+
+// subscribeEntryStream is subscribeRawStream that also returns the entry, so
+// derived streams can attribute their drops to it.
+func (c *clientImpl) subscribeEntryStream(sub Subscription, filter func(RtdsMessage) bool) (*subscriptionEntry, *Stream[RtdsMessage], error) {
 	entry, err := c.subscribeRaw(sub, filter)
 	if err != nil {
-		return nil, err
-	}
-	stream := &Stream[RtdsMessage]{
-		C:   entry.ch,
-		Err: entry.errCh,
-		closeF: func() error {
-			return c.unsubscribeByID(entry.id)
-		},
+		return nil, nil, err
 	}
-	return stream, nil
+	raw := stream.New(entry.ch, entry.errCh, func() error {
+		return c.unsubscribeByID(entry.id)
+	})
+	return entry, raw, nil
 }
 
 func (c *clientImpl) subscribeRaw(sub Subscription, filter func(RtdsMessage) bool) (*subscriptionEntry, error) {
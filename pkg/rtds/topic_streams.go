@@ -0,0 +1,173 @@
+package rtds
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+)
+
+type baseSetter[T any] interface {
+	*T
+	setBase(BaseEvent)
+}
+
+// subscribeTyped subscribes to sub and decodes each payload into T. keep, when
+// set, drops messages that do not belong to the caller.
+func subscribeTyped[T any, P baseSetter[T]](c *clientImpl, sub Subscription, keep func(RtdsMessage, *T) bool) (*Stream[T], error) {
+	entry, rawStream, err := c.subscribeEntryStream(sub, nil)
+	if err != nil {
+		return nil, err
+	}
+	return mapStream(rawStream, entry, func(msg RtdsMessage) (T, bool) {
+		var payload T
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			return payload, false
+		}
+		if keep != nil && !keep(msg, &payload) {
+			var zero T
+			return zero, false
+		}
+		P(&payload).setBase(BaseEvent{
+			Topic:            EventType(msg.Topic),
+			MessageType:      msg.MsgType,
+			MessageTimestamp: msg.Timestamp,
+		})
+		return payload, true
+	}), nil
+}
+
+func (c *clientImpl) SubscribeActivityTradesStream(ctx context.Context, filter *ActivityFilter) (*Stream[ActivityTradeEvent], error) {
+	sub := Subscription{Topic: string(Activity), MsgType: ActivityTradesType, Filters: filter.Filters()}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ActivityTradeEvent) bool {
+		return filter.matches(ev)
+	})
+}
+
+func (c *clientImpl) SubscribeAggOrderbookStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[AggOrderbookEvent], error) {
+	set := filter.assetSet()
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(AggOrderbook), Filters: filter.Filters()}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *AggOrderbookEvent) bool {
+		return inSet(set, ev.AssetID)
+	})
+}
+
+func (c *clientImpl) SubscribeClobPriceChangeStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobPriceChangeEvent], error) {
+	set := filter.assetSet()
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(ClobPriceChange), Filters: filter.Filters()}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ClobPriceChangeEvent) bool {
+		if set == nil {
+			return true
+		}
+		changes := ev.Changes[:0]
+		for _, change := range ev.Changes {
+			if inSet(set, change.AssetID) {
+				changes = append(changes, change)
+			}
+		}
+		ev.Changes = changes
+		return len(changes) > 0
+	})
+}
+
+func (c *clientImpl) SubscribeClobLastTradePriceStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobLastTradePriceEvent], error) {
+	set := filter.assetSet()
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(ClobLastTradePrice), Filters: filter.Filters()}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ClobLastTradePriceEvent) bool {
+		return inSet(set, ev.AssetID)
+	})
+}
+
+func (c *clientImpl) SubscribeClobTickSizeChangeStream(ctx context.Context, filter *ClobMarketFilter) (*Stream[ClobTickSizeChangeEvent], error) {
+	set := filter.assetSet()
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(ClobTickSizeChange), Filters: filter.Filters()}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ClobTickSizeChangeEvent) bool {
+		if set == nil {
+			return true
+		}
+		for _, id := range ev.AssetIDs {
+			if inSet(set, id) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (c *clientImpl) SubscribeMarketCreatedStream(ctx context.Context) (*Stream[MarketLifecycleEvent], error) {
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(MarketCreated)}
+	return subscribeTyped[MarketLifecycleEvent](c, sub, nil)
+}
+
+func (c *clientImpl) SubscribeMarketResolvedStream(ctx context.Context) (*Stream[MarketLifecycleEvent], error) {
+	sub := Subscription{Topic: string(ClobMarket), MsgType: string(MarketResolved)}
+	return subscribeTyped[MarketLifecycleEvent](c, sub, nil)
+}
+
+func (c *clientImpl) SubscribeClobUserOrdersStream(ctx context.Context, filter *ClobUserFilter) (*Stream[ClobUserOrderEvent], error) {
+	sub, markets, err := c.clobUserSubscription(ClobUserOrder, filter)
+	if err != nil {
+		return nil, err
+	}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ClobUserOrderEvent) bool {
+		return inSet(markets, ev.Market)
+	})
+}
+
+func (c *clientImpl) SubscribeClobUserTradesStream(ctx context.Context, filter *ClobUserFilter) (*Stream[ClobUserTradeEvent], error) {
+	sub, markets, err := c.clobUserSubscription(ClobUserTrade, filter)
+	if err != nil {
+		return nil, err
+	}
+	return subscribeTyped(c, sub, func(_ RtdsMessage, ev *ClobUserTradeEvent) bool {
+		return inSet(markets, ev.Market)
+	})
+}
+
+func (c *clientImpl) clobUserSubscription(msgType ClobUserType, filter *ClobUserFilter) (Subscription, map[string]struct{}, error) {
+	var explicit *auth.APIKey
+	var markets map[string]struct{}
+	if filter != nil {
+		explicit = filter.Auth
+		markets = idSet(filter.Markets)
+	}
+	clobAuth := c.clobAuth(explicit)
+	if clobAuth == nil {
+		return Subscription{}, nil, sdkerrors.ErrMissingCreds
+	}
+	return Subscription{Topic: string(ClobUser), MsgType: string(msgType), ClobAuth: clobAuth}, markets, nil
+}
+
+// clobAuth returns explicit credentials, falling back to those set via Authenticate.
+func (c *clientImpl) clobAuth(explicit *auth.APIKey) *ClobAuth {
+	apiKey := explicit
+	if apiKey == nil {
+		c.authMu.RLock()
+		apiKey = c.auth
+		c.authMu.RUnlock()
+	}
+	if apiKey == nil {
+		return nil
+	}
+	return &ClobAuth{
+		Key:        apiKey.Key,
+		Secret:     apiKey.Secret,
+		Passphrase: apiKey.Passphrase,
+	}
+}
+
+func (c *clientImpl) SubscribeRfqRequestsStream(ctx context.Context) (*Stream[RfqRequestEvent], error) {
+	sub := Subscription{Topic: string(Rfq), MsgType: "*"}
+	return subscribeTyped(c, sub, func(msg RtdsMessage, _ *RfqRequestEvent) bool {
+		return strings.HasPrefix(msg.MsgType, "request_")
+	})
+}
+
+func (c *clientImpl) SubscribeRfqQuotesStream(ctx context.Context) (*Stream[RfqQuoteEvent], error) {
+	sub := Subscription{Topic: string(Rfq), MsgType: "*"}
+	return subscribeTyped(c, sub, func(msg RtdsMessage, _ *RfqQuoteEvent) bool {
+		return strings.HasPrefix(msg.MsgType, "quote_")
+	})
+}
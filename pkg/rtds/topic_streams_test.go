@@ -0,0 +1,206 @@
+package rtds
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+	"github.com/gorilla/websocket"
+)
+
+// replyServer answers every subscribe request with frames and records the requests it saw.
+func replyServer(t *testing.T, requests chan<- SubscriptionRequest, frames ...string) Client {
+	t.Helper()
+	s := mockWSServer(t, func(conn *websocket.Conn) {
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var req SubscriptionRequest
+			if json.Unmarshal(msg, &req) != nil || req.Action != SubscribeAction {
+				continue
+			}
+			if requests != nil {
+				requests <- req
+			}
+			for _, frame := range frames {
+				if err := conn.WriteMessage(websocket.TextMessage, []byte(frame)); err != nil {
+					return
+				}
+			}
+		}
+	})
+	t.Cleanup(s.Close)
+	client, err := NewClientWithConfig("ws"+strings.TrimPrefix(s.URL, "http"), DefaultClientConfig())
+	if err != nil {
+		t.Fatalf("NewClientWithConfig failed: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func recvOne[T any](t *testing.T, stream *Stream[T]) T {
+	t.Helper()
+	select {
+	case v := <-stream.C:
+		return v
+	case err := <-stream.Err:
+		t.Fatalf("stream error: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for event")
+	}
+	var zero T
+	return zero
+}
+
+func TestSubscribeAggOrderbookStream_FiltersAssets(t *testing.T) {
+	requests := make(chan SubscriptionRequest, 1)
+	client := replyServer(t, requests,
+		`{"topic":"clob_market","type":"agg_orderbook","timestamp":1,"payload":{"asset_id":"other","bids":[]}}`,
+		`{"topic":"clob_market","type":"agg_orderbook","timestamp":2,"payload":{"asset_id":"100","market":"0xm","bids":[{"price":"0.4","size":"10"}],"asks":[{"price":"0.6","size":"5"}],"tick_size":"0.01","neg_risk":true}}`,
+	)
+	stream, err := client.SubscribeAggOrderbookStream(context.Background(), ClobMarketAssets("100"))
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	req := <-requests
+	raw, _ := json.Marshal(req.Subscriptions[0])
+	if !strings.Contains(string(raw), `"filters":["100"]`) {
+		t.Fatalf("expected asset filter in request, got %s", raw)
+	}
+
+	ev := recvOne(t, stream)
+	if ev.AssetID != "100" || ev.Topic != ClobMarket || ev.MessageType != "agg_orderbook" || ev.MessageTimestamp != 2 {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+	if len(ev.Bids) != 1 || ev.Bids[0].Price.String() != "0.4" || !ev.NegRisk || ev.TickSize.String() != "0.01" {
+		t.Fatalf("unexpected book: %+v", ev)
+	}
+}
+
+func TestSubscribeClobPriceChangeStream_TrimsChanges(t *testing.T) {
+	client := replyServer(t, nil,
+		`{"topic":"clob_market","type":"price_change","payload":{"m":"0xm","t":"1700","pc":[{"a":"100","p":"0.5","s":"3","si":"BUY","bb":"0.49","ba":"0.51"},{"a":"200","p":"0.1"}]}}`,
+	)
+	stream, err := client.SubscribeClobPriceChangeStream(context.Background(), ClobMarketAssets("100"))
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	ev := recvOne(t, stream)
+	if ev.Market != "0xm" || len(ev.Changes) != 1 || ev.Changes[0].AssetID != "100" {
+		t.Fatalf("unexpected price change: %+v", ev)
+	}
+	if ev.Changes[0].BestAsk.String() != "0.51" || ev.Changes[0].Side != "BUY" {
+		t.Fatalf("unexpected level: %+v", ev.Changes[0])
+	}
+}
+
+func TestSubscribeClobTickSizeChangeStream_AcceptsSingleAsset(t *testing.T) {
+	client := replyServer(t, nil,
+		`{"topic":"clob_market","type":"tick_size_change","payload":{"market":"0xm","asset_id":"100","old_tick_size":"0.01","new_tick_size":"0.001"}}`,
+	)
+	stream, err := client.SubscribeClobTickSizeChangeStream(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	ev := recvOne(t, stream)
+	if len(ev.AssetIDs) != 1 || ev.AssetIDs[0] != "100" || ev.NewTickSize.String() != "0.001" {
+		t.Fatalf("unexpected tick size change: %+v", ev)
+	}
+}
+
+func TestSubscribeActivityTradesStream(t *testing.T) {
+	requests := make(chan SubscriptionRequest, 1)
+	client := replyServer(t, requests,
+		`{"topic":"activity","type":"trades","payload":{"eventSlug":"other","price":0.1}}`,
+		`{"topic":"activity","type":"trades","payload":{"eventSlug":"election","price":0.42,"side":"BUY"}}`,
+	)
+	stream, err := client.SubscribeActivityTradesStream(context.Background(), ActivityEventFilter("election"))
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	req := <-requests
+	raw, _ := json.Marshal(req.Subscriptions[0])
+	if !strings.Contains(string(raw), `"event_slug":"election"`) {
+		t.Fatalf("expected event filter in request, got %s", raw)
+	}
+	ev := recvOne(t, stream)
+	if ev.EventSlug != "election" || ev.Price != 0.42 || ev.MessageType != ActivityTradesType {
+		t.Fatalf("unexpected trade: %+v", ev)
+	}
+}
+
+func TestSubscribeClobUserStreams_RequireCreds(t *testing.T) {
+	c := newTestClient()
+	if _, err := c.SubscribeClobUserOrdersStream(context.Background(), nil); !errors.Is(err, sdkerrors.ErrMissingCreds) {
+		t.Fatalf("expected ErrMissingCreds, got %v", err)
+	}
+	if _, err := c.SubscribeClobUserTradesStream(context.Background(), &ClobUserFilter{}); !errors.Is(err, sdkerrors.ErrMissingCreds) {
+		t.Fatalf("expected ErrMissingCreds, got %v", err)
+	}
+}
+
+func TestSubscribeClobUserTradesStream_SendsAuth(t *testing.T) {
+	requests := make(chan SubscriptionRequest, 1)
+	client := replyServer(t, requests,
+		`{"topic":"clob_user","type":"trade","payload":{"id":"t1","market":"0xm","price":"0.5","size":"10","maker_orders":[{"order_id":"o1","matched_amount":"10"}]}}`,
+	)
+	client.Authenticate(&auth.APIKey{Key: "k", Secret: "s", Passphrase: "p"})
+	stream, err := client.SubscribeClobUserTradesStream(context.Background(), &ClobUserFilter{Markets: []string{"0xm"}})
+	if err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	defer stream.Close()
+
+	req := <-requests
+	if got := req.Subscriptions[0].ClobAuth; got == nil || got.Key != "k" || got.Passphrase != "p" {
+		t.Fatalf("expected client credentials in request, got %+v", got)
+	}
+	ev := recvOne(t, stream)
+	if ev.ID != "t1" || len(ev.MakerOrders) != 1 || ev.MakerOrders[0].MatchedAmount.String() != "10" {
+		t.Fatalf("unexpected trade: %+v", ev)
+	}
+}
+
+func TestSubscribeRfqStreams_SplitByKind(t *testing.T) {
+	client := replyServer(t, nil,
+		`{"topic":"rfq","type":"quote_created","payload":{"quoteId":"q1","requestId":"r1","sizeIn":"5"}}`,
+		`{"topic":"rfq","type":"request_created","payload":{"requestId":"r1","price":0.4,"expiry":1700}}`,
+	)
+	requests, err := client.SubscribeRfqRequestsStream(context.Background())
+	if err != nil {
+		t.Fatalf("subscribe requests failed: %v", err)
+	}
+	defer requests.Close()
+
+	req := recvOne(t, requests)
+	if req.RequestID != "r1" || req.Price.String() != "0.4" || req.Expiry != 1700 || req.MessageType != string(RfqRequestCreated) {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+
+	quotes, err := client.SubscribeRfqQuotesStream(context.Background())
+	if err != nil {
+		t.Fatalf("subscribe quotes failed: %v", err)
+	}
+	defer quotes.Close()
+	// The shared rfq subscription is not re-sent, so feed the quote through dispatch.
+	client.(*clientImpl).dispatch(RtdsMessage{Topic: "rfq", MsgType: "quote_created", Payload: json.RawMessage(`{"quoteId":"q2","requestId":"r1"}`)})
+	quote := recvOne(t, quotes)
+	if quote.QuoteID != "q2" || quote.Topic != Rfq {
+		t.Fatalf("unexpected quote: %+v", quote)
+	}
+}
@@ -14,12 +14,20 @@ type ConnectionState string
 const (
 	ConnectionDisconnected ConnectionState = "disconnected"
 	ConnectionConnected    ConnectionState = "connected"
+	ConnectionReconnecting ConnectionState = "reconnecting"
 )
 
 // ConnectionStateEvent captures connection transitions.
+//
+// After a reconnect the client replays its subscriptions and emits a second
+// connected event with Resubscribed set to the number of subscriptions sent,
+// or ResubscribeError describing why the replay failed.
 type ConnectionStateEvent struct {
-	State    ConnectionState `json:"state"`
-	Recorded int64           `json:"recorded"`
+	State            ConnectionState `json:"state"`
+	Attempt          int             `json:"attempt,omitempty"`
+	Resubscribed     int             `json:"resubscribed,omitempty"`
+	ResubscribeError string          `json:"resubscribe_error,omitempty"`
+	Recorded         int64           `json:"recorded"`
 }
 
 // RtdsMessage is the raw RTDS message wrapper.
@@ -180,3 +188,228 @@ type CommentFilter struct {
 	Auth    *auth.APIKey
 	Filters interface{}
 }
+
+// Additional RTDS topics.
+const (
+	ClobMarket EventType = "clob_market"
+	ClobUser   EventType = "clob_user"
+	Rfq        EventType = "rfq"
+)
+
+// ActivityTradesType is the activity message type for public trades.
+const ActivityTradesType = "trades"
+
+// ClobMarketType enumerates clob_market message types.
+type ClobMarketType string
+
+const (
+	AggOrderbook       ClobMarketType = "agg_orderbook"
+	ClobPriceChange    ClobMarketType = "price_change"
+	ClobLastTradePrice ClobMarketType = "last_trade_price"
+	ClobTickSizeChange ClobMarketType = "tick_size_change"
+	MarketCreated      ClobMarketType = "market_created"
+	MarketResolved     ClobMarketType = "market_resolved"
+)
+
+// ClobUserType enumerates clob_user message types.
+type ClobUserType string
+
+const (
+	ClobUserOrder ClobUserType = "order"
+	ClobUserTrade ClobUserType = "trade"
+)
+
+// RfqType enumerates rfq message types.
+type RfqType string
+
+const (
+	RfqRequestCreated  RfqType = "request_created"
+	RfqRequestEdited   RfqType = "request_edited"
+	RfqRequestCanceled RfqType = "request_canceled"
+	RfqRequestExpired  RfqType = "request_expired"
+	RfqQuoteCreated    RfqType = "quote_created"
+	RfqQuoteEdited     RfqType = "quote_edited"
+	RfqQuoteCanceled   RfqType = "quote_canceled"
+	RfqQuoteExpired    RfqType = "quote_expired"
+)
+
+func (b *BaseEvent) setBase(base BaseEvent) { *b = base }
+
+// ActivityTradeEvent is an activity stream payload for public trades. It shares the orders_matched shape.
+type ActivityTradeEvent = OrdersMatchedEvent
+
+// ClobOrderbookLevel is a single aggregated price level.
+type ClobOrderbookLevel struct {
+	Price types.Decimal `json:"price"`
+	Size  types.Decimal `json:"size"`
+}
+
+// AggOrderbookEvent is a clob_market aggregated orderbook snapshot.
+type AggOrderbookEvent struct {
+	BaseEvent
+	AssetID      string               `json:"asset_id"`
+	Market       string               `json:"market"`
+	Hash         string               `json:"hash"`
+	Bids         []ClobOrderbookLevel `json:"bids"`
+	Asks         []ClobOrderbookLevel `json:"asks"`
+	MinOrderSize types.Decimal        `json:"min_order_size"`
+	TickSize     types.Decimal        `json:"tick_size"`
+	NegRisk      bool                 `json:"neg_risk"`
+	Timestamp    string               `json:"timestamp"`
+}
+
+// ClobPriceLevelChange is a single level update inside a ClobPriceChangeEvent.
+type ClobPriceLevelChange struct {
+	AssetID string        `json:"a"`
+	Hash    string        `json:"h"`
+	Price   types.Decimal `json:"p"`
+	Size    types.Decimal `json:"s"`
+	Side    string        `json:"si"`
+	BestBid types.Decimal `json:"bb"`
+	BestAsk types.Decimal `json:"ba"`
+}
+
+// ClobPriceChangeEvent is a clob_market price_change payload.
+type ClobPriceChangeEvent struct {
+	BaseEvent
+	Market    string                 `json:"m"`
+	Changes   []ClobPriceLevelChange `json:"pc"`
+	Timestamp string                 `json:"t"`
+}
+
+// ClobLastTradePriceEvent is a clob_market last_trade_price payload.
+type ClobLastTradePriceEvent struct {
+	BaseEvent
+	AssetID    string        `json:"asset_id"`
+	Market     string        `json:"market"`
+	Price      types.Decimal `json:"price"`
+	Size       types.Decimal `json:"size"`
+	Side       string        `json:"side"`
+	FeeRateBps types.Decimal `json:"fee_rate_bps"`
+}
+
+// ClobTickSizeChangeEvent is a clob_market tick_size_change payload.
+type ClobTickSizeChangeEvent struct {
+	BaseEvent
+	Market      string        `json:"market"`
+	AssetIDs    stringList    `json:"asset_id"`
+	OldTickSize types.Decimal `json:"old_tick_size"`
+	NewTickSize types.Decimal `json:"new_tick_size"`
+}
+
+// MarketLifecycleEvent is a clob_market market_created or market_resolved payload.
+// BaseEvent.MessageType tells the two apart.
+type MarketLifecycleEvent struct {
+	BaseEvent
+	Market       string        `json:"market"`
+	AssetIDs     []string      `json:"asset_ids"`
+	MinOrderSize types.Decimal `json:"min_order_size"`
+	TickSize     types.Decimal `json:"tick_size"`
+	NegRisk      bool          `json:"neg_risk"`
+}
+
+// ClobUserOrderEvent is a clob_user order payload.
+type ClobUserOrderEvent struct {
+	BaseEvent
+	ID           string        `json:"id"`
+	AssetID      string        `json:"asset_id"`
+	Market       string        `json:"market"`
+	Owner        string        `json:"owner"`
+	MakerAddress string        `json:"maker_address"`
+	Outcome      string        `json:"outcome"`
+	Side         string        `json:"side"`
+	OrderType    string        `json:"order_type"`
+	Status       string        `json:"status"`
+	Type         string        `json:"type"`
+	Price        types.Decimal `json:"price"`
+	OriginalSize types.Decimal `json:"original_size"`
+	SizeMatched  types.Decimal `json:"size_matched"`
+	CreatedAt    string        `json:"created_at"`
+	Expiration   string        `json:"expiration"`
+}
+
+// ClobMakerOrder is a maker fill inside a ClobUserTradeEvent.
+type ClobMakerOrder struct {
+	OrderID       string        `json:"order_id"`
+	AssetID       string        `json:"asset_id"`
+	Owner         string        `json:"owner"`
+	MakerAddress  string        `json:"maker_address"`
+	Outcome       string        `json:"outcome"`
+	Side          string        `json:"side"`
+	Price         types.Decimal `json:"price"`
+	MatchedAmount types.Decimal `json:"matched_amount"`
+	FeeRateBps    types.Decimal `json:"fee_rate_bps"`
+}
+
+// ClobUserTradeEvent is a clob_user trade payload.
+type ClobUserTradeEvent struct {
+	BaseEvent
+	ID              string           `json:"id"`
+	AssetID         string           `json:"asset_id"`
+	Market          string           `json:"market"`
+	Owner           string           `json:"owner"`
+	MakerAddress    string           `json:"maker_address"`
+	Outcome         string           `json:"outcome"`
+	Side            string           `json:"side"`
+	Status          string           `json:"status"`
+	TraderSide      string           `json:"trader_side"`
+	TakerOrderID    string           `json:"taker_order_id"`
+	TransactionHash string           `json:"transaction_hash"`
+	Price           types.Decimal    `json:"price"`
+	Size            types.Decimal    `json:"size"`
+	FeeRateBps      types.Decimal    `json:"fee_rate_bps"`
+	BucketIndex     int              `json:"bucket_index"`
+	MatchTime       string           `json:"match_time"`
+	LastUpdate      string           `json:"last_update"`
+	MakerOrders     []ClobMakerOrder `json:"maker_orders"`
+}
+
+// RfqRequestEvent is an rfq request_* payload.
+type RfqRequestEvent struct {
+	BaseEvent
+	RequestID    string        `json:"requestId"`
+	ProxyAddress string        `json:"proxyAddress"`
+	Market       string        `json:"market"`
+	Token        string        `json:"token"`
+	Complement   string        `json:"complement"`
+	State        string        `json:"state"`
+	Side         string        `json:"side"`
+	SizeIn       types.Decimal `json:"sizeIn"`
+	SizeOut      types.Decimal `json:"sizeOut"`
+	Price        types.Decimal `json:"price"`
+	Expiry       int64         `json:"expiry"`
+}
+
+// RfqQuoteEvent is an rfq quote_* payload.
+type RfqQuoteEvent struct {
+	BaseEvent
+	QuoteID      string        `json:"quoteId"`
+	RequestID    string        `json:"requestId"`
+	ProxyAddress string        `json:"proxyAddress"`
+	Token        string        `json:"token"`
+	Complement   string        `json:"complement"`
+	Condition    string        `json:"condition"`
+	State        string        `json:"state"`
+	Side         string        `json:"side"`
+	SizeIn       types.Decimal `json:"sizeIn"`
+	SizeOut      types.Decimal `json:"sizeOut"`
+	Expiry       int64         `json:"expiry"`
+}
+
+// ActivityFilter restricts activity streams to one event or market. The zero value matches everything.
+type ActivityFilter struct {
+	EventSlug  string
+	MarketSlug string
+}
+
+// ClobMarketFilter restricts clob_market streams to a set of asset IDs. An empty filter matches everything.
+type ClobMarketFilter struct {
+	AssetIDs []string
+}
+
+// ClobUserFilter configures clob_user streams. Auth falls back to the client's credentials;
+// Markets, when set, limits delivery to those condition IDs.
+type ClobUserFilter struct {
+	Auth    *auth.APIKey
+	Markets []string
+}
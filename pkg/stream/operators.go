@@ -0,0 +1,489 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// sink holds an operator's output channels. Sends and the final close are
+// serialized by the operator that owns it.
+type sink[T any] struct {
+	out      chan T
+	errs     chan error
+	lagError func(count int) error
+}
+
+func newSink[T any](o options) *sink[T] {
+	return &sink[T]{
+		out:      make(chan T, o.buffer),
+		errs:     make(chan error, o.errBuffer),
+		lagError: o.lagError,
+	}
+}
+
+// emit delivers v without blocking, reporting lag when the consumer is behind.
+func (s *sink[T]) emit(v T) {
+	select {
+	case s.out <- v:
+	default:
+		s.fail(s.lagError(1))
+	}
+}
+
+// fail delivers err without blocking; it is discarded when the error buffer is full.
+func (s *sink[T]) fail(err error) {
+	select {
+	case s.errs <- err:
+	default:
+	}
+}
+
+func (s *sink[T]) close() {
+	close(s.out)
+	close(s.errs)
+}
+
+// stage starts run in a goroutine between src and a new output stream. run
+// returns when the source is exhausted or ctx is done; the source is then
+// released and the output closed. Closing the output cancels ctx, waits for
+// the goroutine to exit and returns the source's Close error.
+func stage[T, U any](ctx context.Context, src *Stream[T], op string, opts []Option, run func(ctx context.Context, s *sink[U])) *Stream[U] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := newSink[U](buildOptions(op, opts))
+	done := make(chan struct{})
+	var closeErr error
+	go func() {
+		defer close(done)
+		defer s.close()
+		defer func() { closeErr = src.Close() }()
+		defer cancel()
+		run(ctx, s)
+		drainErrs(src.Err, s.fail)
+	}()
+	return New(s.out, s.errs, func() error {
+		cancel()
+		<-done
+		return closeErr
+	})
+}
+
+// drainErrs forwards errors already buffered on errs, so an error sent just
+// before the source closed is not lost to select ordering.
+func drainErrs(errs <-chan error, fail func(error)) {
+	for {
+		select {
+		case err, ok := <-errs:
+			if !ok {
+				return
+			}
+			fail(err)
+		default:
+			return
+		}
+	}
+}
+
+// forwardErr copies a source error to the sink. It returns nil once the
+// source error channel is closed so callers can stop selecting on it.
+func forwardErr[U any](s *sink[U], errs <-chan error, err error, ok bool) <-chan error {
+	if !ok {
+		return nil
+	}
+	s.fail(err)
+	return errs
+}
+
+// FilterMap applies fn to every message and forwards the results for which
+// fn reports true.
+func FilterMap[T, U any](ctx context.Context, src *Stream[T], fn func(T) (U, bool), opts ...Option) *Stream[U] {
+	return stage(ctx, src, "filter_map", opts, func(ctx context.Context, s *sink[U]) {
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					return
+				}
+				if mapped, keep := fn(v); keep {
+					s.emit(mapped)
+				}
+			case err, ok := <-errs:
+				errs = forwardErr(s, errs, err, ok)
+			}
+		}
+	})
+}
+
+// Map applies fn to every message.
+func Map[T, U any](ctx context.Context, src *Stream[T], fn func(T) U, opts ...Option) *Stream[U] {
+	return FilterMap(ctx, src, func(v T) (U, bool) { return fn(v), true }, append([]Option{WithLagError(lagFor("map"))}, opts...)...)
+}
+
+// Filter forwards the messages for which keep reports true.
+func Filter[T any](ctx context.Context, src *Stream[T], keep func(T) bool, opts ...Option) *Stream[T] {
+	return FilterMap(ctx, src, func(v T) (T, bool) { return v, keep(v) }, append([]Option{WithLagError(lagFor("filter"))}, opts...)...)
+}
+
+func lagFor(op string) func(int) error {
+	return func(count int) error { return LaggedError{Count: count, Op: op} }
+}
+
+// Merge interleaves messages and errors from all sources. The output closes
+// once every source is exhausted; closing it closes every source and returns
+// their joined Close errors.
+func Merge[T any](ctx context.Context, srcs ...*Stream[T]) *Stream[T] {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := newSink[T](buildOptions("merge", nil))
+	done := make(chan struct{})
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var closeErrs []error
+	for _, src := range srcs {
+		if src == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(src *Stream[T]) {
+			defer wg.Done()
+			defer func() {
+				if err := src.Close(); err != nil {
+					mu.Lock()
+					closeErrs = append(closeErrs, err)
+					mu.Unlock()
+				}
+			}()
+			errs := src.Err
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-src.C:
+					if !ok {
+						mu.Lock()
+						drainErrs(src.Err, s.fail)
+						mu.Unlock()
+						return
+					}
+					mu.Lock()
+					s.emit(v)
+					mu.Unlock()
+				case err, ok := <-errs:
+					if !ok {
+						errs = nil
+						continue
+					}
+					mu.Lock()
+					s.fail(err)
+					mu.Unlock()
+				}
+			}
+		}(src)
+	}
+	go func() {
+		defer close(done)
+		wg.Wait()
+		cancel()
+		s.close()
+	}()
+	return New(s.out, s.errs, func() error {
+		cancel()
+		<-done
+		return errors.Join(closeErrs...)
+	})
+}
+
+// Throttle emits at most one message per interval. The first message of a
+// burst is delivered immediately and the latest message seen during the
+// interval is delivered when it ends, so the final value is never lost.
+func Throttle[T any](ctx context.Context, src *Stream[T], interval time.Duration, opts ...Option) *Stream[T] {
+	return stage(ctx, src, "throttle", opts, func(ctx context.Context, s *sink[T]) {
+		var (
+			timer   *time.Timer
+			tick    <-chan time.Time
+			pending T
+			held    bool
+		)
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					if held {
+						s.emit(pending)
+					}
+					return
+				}
+				if tick != nil {
+					pending, held = v, true
+					continue
+				}
+				s.emit(v)
+				timer = time.NewTimer(interval)
+				tick = timer.C
+			case <-tick:
+				if !held {
+					tick = nil
+					continue
+				}
+				s.emit(pending)
+				var zero T
+				pending, held = zero, false
+				timer.Reset(interval)
+			case err, ok := <-errs:
+				errs = forwardErr(s, errs, err, ok)
+			}
+		}
+	})
+}
+
+// Debounce emits the latest message once the source has been quiet for the
+// given duration. A pending message is flushed when the source closes.
+func Debounce[T any](ctx context.Context, src *Stream[T], quiet time.Duration, opts ...Option) *Stream[T] {
+	return stage(ctx, src, "debounce", opts, func(ctx context.Context, s *sink[T]) {
+		timer := time.NewTimer(quiet)
+		timer.Stop()
+		defer timer.Stop()
+		var (
+			pending T
+			held    bool
+		)
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					if held {
+						s.emit(pending)
+					}
+					return
+				}
+				pending, held = v, true
+				timer.Reset(quiet)
+			case <-timer.C:
+				if held {
+					s.emit(pending)
+					var zero T
+					pending, held = zero, false
+				}
+			case err, ok := <-errs:
+				errs = forwardErr(s, errs, err, ok)
+			}
+		}
+	})
+}
+
+// SampleLatest keeps only the latest message per key and emits the retained
+// messages every interval, in the order their keys were first seen during
+// the interval. Remaining messages are flushed when the source closes.
+// interval must be positive.
+func SampleLatest[T any, K comparable](ctx context.Context, src *Stream[T], interval time.Duration, key func(T) K, opts ...Option) *Stream[T] {
+	return stage(ctx, src, "sample_latest", opts, func(ctx context.Context, s *sink[T]) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		latest := make(map[K]T)
+		var order []K
+		flush := func() {
+			for _, k := range order {
+				s.emit(latest[k])
+				delete(latest, k)
+			}
+			order = order[:0]
+		}
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					flush()
+					return
+				}
+				k := key(v)
+				if _, seen := latest[k]; !seen {
+					order = append(order, k)
+				}
+				latest[k] = v
+			case <-ticker.C:
+				flush()
+			case err, ok := <-errs:
+				errs = forwardErr(s, errs, err, ok)
+			}
+		}
+	})
+}
+
+// Batch groups messages into slices of up to size messages. A partial batch
+// is emitted once maxWait has passed since its first message (never when
+// maxWait <= 0) and when the source closes.
+func Batch[T any](ctx context.Context, src *Stream[T], size int, maxWait time.Duration, opts ...Option) *Stream[[]T] {
+	if size <= 0 {
+		size = 1
+	}
+	return stage(ctx, src, "batch", opts, func(ctx context.Context, s *sink[[]T]) {
+		var (
+			batch []T
+			timer *time.Timer
+			tick  <-chan time.Time
+		)
+		flush := func() {
+			if timer != nil {
+				timer.Stop()
+				tick = nil
+			}
+			if len(batch) > 0 {
+				s.emit(batch)
+				batch = nil
+			}
+		}
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					flush()
+					return
+				}
+				if len(batch) == 0 && maxWait > 0 {
+					if timer == nil {
+						timer = time.NewTimer(maxWait)
+					} else {
+						timer.Reset(maxWait)
+					}
+					tick = timer.C
+				}
+				batch = append(batch, v)
+				if len(batch) >= size {
+					flush()
+				}
+			case <-tick:
+				tick = nil
+				if len(batch) > 0 {
+					s.emit(batch)
+					batch = nil
+				}
+			case err, ok := <-errs:
+				errs = forwardErr(s, errs, err, ok)
+			}
+		}
+	})
+}
+
+// FanOut copies every message and error from src to n independent outputs.
+// Each output lags on its own, so a slow consumer does not hold back the
+// others. The source is closed once every output is closed or ctx is done;
+// closing the last output waits for that and returns the source's Close error.
+func FanOut[T any](ctx context.Context, src *Stream[T], n int, opts ...Option) []*Stream[T] {
+	if n <= 0 {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	o := buildOptions("fan_out", opts)
+	done := make(chan struct{})
+	var closeErr error
+
+	var mu sync.Mutex
+	open := n
+	sinks := make([]*sink[T], n)
+	closed := make([]bool, n)
+	// release closes output i; the caller holds mu.
+	release := func(i int) {
+		if closed[i] {
+			return
+		}
+		closed[i] = true
+		sinks[i].close()
+		open--
+	}
+	outs := make([]*Stream[T], n)
+	for i := range sinks {
+		sinks[i] = newSink[T](o)
+		outs[i] = New(sinks[i].out, sinks[i].errs, func() error {
+			mu.Lock()
+			release(i)
+			last := open == 0
+			mu.Unlock()
+			if !last {
+				return nil
+			}
+			cancel()
+			<-done
+			return closeErr
+		})
+	}
+
+	broadcast := func(send func(*sink[T])) {
+		mu.Lock()
+		defer mu.Unlock()
+		for i, s := range sinks {
+			if !closed[i] {
+				send(s)
+			}
+		}
+	}
+
+	go func() {
+		defer close(done)
+		defer func() {
+			mu.Lock()
+			for i := range sinks {
+				release(i)
+			}
+			mu.Unlock()
+		}()
+		defer func() { closeErr = src.Close() }()
+		defer cancel()
+		errs := src.Err
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-src.C:
+				if !ok {
+					drainErrs(src.Err, func(err error) {
+						broadcast(func(s *sink[T]) { s.fail(err) })
+					})
+					return
+				}
+				broadcast(func(s *sink[T]) { s.emit(v) })
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				broadcast(func(s *sink[T]) { s.fail(err) })
+			}
+		}
+	}()
+	return outs
+}
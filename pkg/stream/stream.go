@@ -0,0 +1,123 @@
+// Package stream provides the subscription stream type shared by the CLOB
+// WebSocket and RTDS clients, together with operators for composing streams:
+// Map, Filter, Merge, Throttle, Debounce, SampleLatest, Batch and FanOut.
+//
+// Every operator runs a single goroutine that owns its output channels. The
+// goroutine exits, closing the output and its source, when the source is
+// exhausted, when the context is cancelled or when the output is closed.
+// Errors from the source, including lag, are forwarded to the output's Err
+// channel. An operator never blocks on a slow consumer: it drops the value
+// and reports a LaggedError instead, the same way the clients do.
+package stream
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	defaultBuffer    = 100
+	defaultErrBuffer = 10
+)
+
+// Stream delivers messages and async errors for a subscription.
+type Stream[T any] struct {
+	C      <-chan T
+	Err    <-chan error
+	closeF func() error
+}
+
+// New wraps channels in a Stream. closeF is called by Close and must be safe
+// to call more than once; it may be nil.
+func New[T any](c <-chan T, errs <-chan error, closeF func() error) *Stream[T] {
+	return &Stream[T]{C: c, Err: errs, closeF: closeF}
+}
+
+// Close stops the subscription and closes the stream.
+func (s *Stream[T]) Close() error {
+	if s == nil || s.closeF == nil {
+		return nil
+	}
+	return s.closeF()
+}
+
+// Lagged is implemented by errors reporting messages lost to backpressure.
+type Lagged interface {
+	error
+	LagCount() int
+}
+
+// IsLagged reports whether err signals lost messages and how many were lost.
+func IsLagged(err error) (int, bool) {
+	var lagged Lagged
+	if errors.As(err, &lagged) {
+		return lagged.LagCount(), true
+	}
+	return 0, false
+}
+
+// LaggedError indicates an operator dropped messages because its consumer
+// fell behind.
+type LaggedError struct {
+	Count int
+	// Op names the operator that dropped the messages.
+	Op string
+}
+
+func (e LaggedError) Error() string {
+	if e.Op == "" {
+		return fmt.Sprintf("stream lagged, missed %d messages", e.Count)
+	}
+	return fmt.Sprintf("stream lagged, missed %d messages (op=%s)", e.Count, e.Op)
+}
+
+// LagCount returns the number of messages missed.
+func (e LaggedError) LagCount() int { return e.Count }
+
+// Option configures an operator's output.
+type Option func(*options)
+
+type options struct {
+	buffer    int
+	errBuffer int
+	lagError  func(count int) error
+}
+
+// WithBuffer sets the capacity of the output channel. Defaults to 100.
+func WithBuffer(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.buffer = n
+		}
+	}
+}
+
+// WithErrBuffer sets the capacity of the output error channel. Defaults to 10.
+func WithErrBuffer(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.errBuffer = n
+		}
+	}
+}
+
+// WithLagError customizes the error reported when the operator drops a
+// message, so callers can keep their own lag error types.
+func WithLagError(fn func(count int) error) Option {
+	return func(o *options) {
+		o.lagError = fn
+	}
+}
+
+func buildOptions(op string, opts []Option) options {
+	o := options{buffer: defaultBuffer, errBuffer: defaultErrBuffer}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	if o.lagError == nil {
+		o.lagError = func(count int) error { return LaggedError{Count: count, Op: op} }
+	}
+	return o
+}
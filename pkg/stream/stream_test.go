@@ -0,0 +1,398 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type source[T any] struct {
+	c        chan T
+	errs     chan error
+	closed   atomic.Int32
+	closeErr error
+	*Stream[T]
+}
+
+func newSource[T any](buffer int) *source[T] {
+	s := &source[T]{c: make(chan T, buffer), errs: make(chan error, buffer)}
+	s.Stream = New(s.c, s.errs, func() error {
+		s.closed.Add(1)
+		return s.closeErr
+	})
+	return s
+}
+
+func (s *source[T]) end() {
+	close(s.c)
+	close(s.errs)
+}
+
+func collect[T any](t *testing.T, s *Stream[T]) []T {
+	t.Helper()
+	var out []T
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case v, ok := <-s.C:
+			if !ok {
+				return out
+			}
+			out = append(out, v)
+		case <-timeout:
+			t.Fatalf("stream did not close, got %v", out)
+		}
+	}
+}
+
+func waitClosed[T any](t *testing.T, s *Stream[T]) {
+	t.Helper()
+	select {
+	case _, ok := <-s.C:
+		for ok {
+			_, ok = <-s.C
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("output not closed")
+	}
+}
+
+func TestMapFilter(t *testing.T) {
+	src := newSource[int](10)
+	for i := 1; i <= 6; i++ {
+		src.c <- i
+	}
+	src.end()
+
+	even := Filter(context.Background(), src.Stream, func(v int) bool { return v%2 == 0 })
+	labels := Map(context.Background(), even, func(v int) string { return fmt.Sprint("n", v) })
+	got := collect(t, labels)
+	if fmt.Sprint(got) != "[n2 n4 n6]" {
+		t.Fatalf("got %v", got)
+	}
+	if src.closed.Load() == 0 {
+		t.Fatal("source not closed after exhaustion")
+	}
+}
+
+func TestErrorsAndLagPropagate(t *testing.T) {
+	src := newSource[int](10)
+	src.errs <- errors.New("boom")
+	src.c <- 1
+	src.c <- 2
+	src.c <- 3
+	src.end()
+
+	out := Map(context.Background(), src.Stream, func(v int) int { return v }, WithBuffer(1))
+	// Let the operator drain the source before reading so the drops are deterministic.
+	deadline := time.Now().Add(2 * time.Second)
+	for src.closed.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	got := collect(t, out)
+	if len(got) != 1 {
+		t.Fatalf("expected one delivered value with buffer 1, got %v", got)
+	}
+	var sawBoom bool
+	var lagged int
+	for err := range out.Err {
+		if n, ok := IsLagged(err); ok {
+			lagged += n
+			var le LaggedError
+			if !errors.As(err, &le) || le.Op != "map" {
+				t.Fatalf("unexpected lag error %#v", err)
+			}
+			continue
+		}
+		if err.Error() == "boom" {
+			sawBoom = true
+		}
+	}
+	if !sawBoom {
+		t.Fatal("source error not forwarded")
+	}
+	if lagged != 2 {
+		t.Fatalf("expected 2 lagged, got %d", lagged)
+	}
+}
+
+type customLag struct{ n int }
+
+func (e customLag) Error() string { return "custom lag" }
+func (e customLag) LagCount() int { return e.n }
+
+func TestIsLagged(t *testing.T) {
+	if n, ok := IsLagged(fmt.Errorf("wrapped: %w", customLag{n: 4})); !ok || n != 4 {
+		t.Fatalf("IsLagged = %d %v", n, ok)
+	}
+	if _, ok := IsLagged(errors.New("other")); ok {
+		t.Fatal("plain error reported as lag")
+	}
+}
+
+func TestCloseStopsOperatorAndSource(t *testing.T) {
+	src := newSource[int](1)
+	out := Filter(context.Background(), src.Stream, func(int) bool { return true })
+	if err := out.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	waitClosed(t, out)
+	if src.closed.Load() == 0 {
+		t.Fatal("source not closed")
+	}
+}
+
+func TestCloseReturnsSourceCloseError(t *testing.T) {
+	unsubscribe := errors.New("unsubscribe failed")
+	ops := map[string]func(*Stream[int]) *Stream[int]{
+		"map": func(src *Stream[int]) *Stream[int] {
+			return Map(context.Background(), src, func(v int) int { return v })
+		},
+		"merge": func(src *Stream[int]) *Stream[int] {
+			return Merge(context.Background(), src)
+		},
+		"fan_out": func(src *Stream[int]) *Stream[int] {
+			return FanOut(context.Background(), src, 1)[0]
+		},
+	}
+	for name, op := range ops {
+		src := newSource[int](1)
+		src.closeErr = unsubscribe
+		out := op(src.Stream)
+		if err := out.Close(); !errors.Is(err, unsubscribe) {
+			t.Fatalf("%s: close returned %v", name, err)
+		}
+		if src.closed.Load() == 0 {
+			t.Fatalf("%s: source not closed before Close returned", name)
+		}
+	}
+}
+
+func TestContextCancelStopsOperator(t *testing.T) {
+	src := newSource[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+	out := Debounce(ctx, src.Stream, time.Hour)
+	cancel()
+	waitClosed(t, out)
+	if src.closed.Load() == 0 {
+		t.Fatal("source not closed")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, b := newSource[int](10), newSource[int](10)
+	a.c <- 1
+	a.c <- 2
+	b.c <- 3
+	b.errs <- errors.New("b failed")
+	a.end()
+	b.end()
+
+	out := Merge(context.Background(), a.Stream, b.Stream)
+	got := collect(t, out)
+	sum := 0
+	for _, v := range got {
+		sum += v
+	}
+	if len(got) != 3 || sum != 6 {
+		t.Fatalf("got %v", got)
+	}
+	if err := <-out.Err; err == nil || err.Error() != "b failed" {
+		t.Fatalf("expected merged error, got %v", err)
+	}
+}
+
+func TestMergeCloseClosesSources(t *testing.T) {
+	a, b := newSource[int](1), newSource[int](1)
+	out := Merge(context.Background(), a.Stream, b.Stream)
+	_ = out.Close()
+	waitClosed(t, out)
+	if a.closed.Load() == 0 || b.closed.Load() == 0 {
+		t.Fatal("sources not closed")
+	}
+}
+
+func TestThrottleKeepsLeadingAndTrailing(t *testing.T) {
+	src := newSource[int](10)
+	out := Throttle(context.Background(), src.Stream, 50*time.Millisecond)
+	for i := 1; i <= 5; i++ {
+		src.c <- i
+	}
+	select {
+	case v := <-out.C:
+		if v != 1 {
+			t.Fatalf("leading value = %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no leading value")
+	}
+	select {
+	case v := <-out.C:
+		if v != 5 {
+			t.Fatalf("trailing value = %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no trailing value")
+	}
+	src.end()
+	if rest := collect(t, out); len(rest) != 0 {
+		t.Fatalf("unexpected extra values %v", rest)
+	}
+}
+
+func TestDebounceEmitsAfterQuiet(t *testing.T) {
+	src := newSource[int](10)
+	out := Debounce(context.Background(), src.Stream, 30*time.Millisecond)
+	src.c <- 1
+	src.c <- 2
+	src.c <- 3
+	select {
+	case v := <-out.C:
+		if v != 3 {
+			t.Fatalf("debounced value = %d", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no debounced value")
+	}
+	src.c <- 4
+	src.end()
+	if got := collect(t, out); fmt.Sprint(got) != "[4]" {
+		t.Fatalf("pending value not flushed on close: %v", got)
+	}
+}
+
+type quote struct {
+	asset string
+	price int
+}
+
+func TestSampleLatestPerKey(t *testing.T) {
+	src := newSource[quote](10)
+	src.c <- quote{"a", 1}
+	src.c <- quote{"b", 1}
+	src.c <- quote{"a", 2}
+	src.c <- quote{"a", 3}
+	src.end()
+
+	out := SampleLatest(context.Background(), src.Stream, time.Hour, func(q quote) string { return q.asset })
+	got := collect(t, out)
+	if fmt.Sprint(got) != "[{a 3} {b 1}]" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestSampleLatestTicks(t *testing.T) {
+	src := newSource[quote](10)
+	out := SampleLatest(context.Background(), src.Stream, 20*time.Millisecond, func(q quote) string { return q.asset })
+	defer out.Close()
+	src.c <- quote{"a", 1}
+	src.c <- quote{"a", 2}
+	select {
+	case q := <-out.C:
+		if q.price != 2 {
+			t.Fatalf("sampled %v", q)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no sample emitted")
+	}
+}
+
+func TestBatchBySize(t *testing.T) {
+	src := newSource[int](10)
+	for i := 1; i <= 5; i++ {
+		src.c <- i
+	}
+	src.end()
+	got := collect(t, Batch(context.Background(), src.Stream, 2, 0))
+	if fmt.Sprint(got) != "[[1 2] [3 4] [5]]" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestBatchByTime(t *testing.T) {
+	src := newSource[int](10)
+	out := Batch(context.Background(), src.Stream, 100, 20*time.Millisecond)
+	defer out.Close()
+	src.c <- 1
+	src.c <- 2
+	select {
+	case b := <-out.C:
+		if fmt.Sprint(b) != "[1 2]" {
+			t.Fatalf("batch = %v", b)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("partial batch not emitted after maxWait")
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	src := newSource[int](10)
+	outs := FanOut(context.Background(), src.Stream, 3, WithBuffer(1))
+	if len(outs) != 3 {
+		t.Fatalf("got %d outputs", len(outs))
+	}
+	src.c <- 1
+	src.errs <- errors.New("shared")
+
+	for i, out := range outs[:2] {
+		select {
+		case v := <-out.C:
+			if v != 1 {
+				t.Fatalf("output %d got %d", i, v)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("output %d got nothing", i)
+		}
+		select {
+		case err := <-out.Err:
+			if err.Error() != "shared" {
+				t.Fatalf("output %d err %v", i, err)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("output %d missing error", i)
+		}
+	}
+
+	// The third output never reads, so it lags without holding back the others.
+	src.c <- 2
+	for i, out := range outs[:2] {
+		select {
+		case v := <-out.C:
+			if v != 2 {
+				t.Fatalf("output %d got %d", i, v)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("output %d blocked by slow sibling", i)
+		}
+	}
+	deadline := time.After(time.Second)
+	for lagged := false; !lagged; {
+		select {
+		case err := <-outs[2].Err:
+			_, lagged = IsLagged(err)
+		case <-deadline:
+			t.Fatal("slow output did not report lag")
+		}
+	}
+
+	_ = outs[0].Close()
+	waitClosed(t, outs[0])
+	if src.closed.Load() != 0 {
+		t.Fatal("source closed while outputs remain")
+	}
+	_ = outs[1].Close()
+	_ = outs[2].Close()
+	_ = outs[2].Close()
+	waitClosed(t, outs[1])
+	deadline = time.After(time.Second)
+	for src.closed.Load() == 0 {
+		select {
+		case <-deadline:
+			t.Fatal("source not closed after all outputs closed")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
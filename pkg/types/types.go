@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
@@ -91,3 +93,95 @@ func (u *U256) UnmarshalJSON(data []byte) error {
 	u.Int = value
 	return nil
 }
+
+// ParseDecimal parses a numeric string as returned by the APIs.
+// An empty string yields zero without an error.
+func ParseDecimal(s string) (decimal.Decimal, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid decimal value: %q", s)
+	}
+	return d, nil
+}
+
+// CachedDecimal memoizes the parsed form of a numeric string field, so that
+// decoded API types can expose it as a decimal without re-parsing. The raw
+// value is kept so that a field mutated after decoding is re-parsed instead of
+// served stale. Unparseable values yield zero; use Validate to detect them.
+type CachedDecimal struct {
+	raw   string
+	value decimal.Decimal
+	err   error
+	ok    bool
+}
+
+// NewCachedDecimal parses raw with ParseDecimal and remembers the result.
+func NewCachedDecimal(raw string) CachedDecimal {
+	value, err := ParseDecimal(raw)
+	return CachedDecimal{raw: raw, value: value, err: err, ok: true}
+}
+
+// Value returns raw as a decimal, using the cached form when raw is unchanged.
+func (c CachedDecimal) Value(raw string) decimal.Decimal {
+	value, _ := c.parse(raw)
+	return value
+}
+
+// Validate reports whether raw parses, naming field in the error.
+func (c CachedDecimal) Validate(field, raw string) error {
+	if _, err := c.parse(raw); err != nil {
+		return fmt.Errorf("%s: %w", field, err)
+	}
+	return nil
+}
+
+func (c CachedDecimal) parse(raw string) (decimal.Decimal, error) {
+	if c.ok && c.raw == raw {
+		return c.value, c.err
+	}
+	return ParseDecimal(raw)
+}
+
+// ParseTimestamp parses a Unix timestamp string. The APIs mix second,
+// millisecond, microsecond and nanosecond resolution, so the unit is inferred
+// from the magnitude. Fractional seconds are accepted. An empty string yields
+// the zero time without an error.
+func ParseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return UnixTimestamp(n), nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp value: %q", s)
+	}
+	secs := d.IntPart()
+	nanos := d.Sub(decimal.NewFromInt(secs)).Shift(9).IntPart()
+	return time.Unix(secs, nanos).UTC(), nil
+}
+
+// UnixTimestamp converts an integer Unix timestamp of unknown resolution
+// (seconds, milliseconds, microseconds or nanoseconds) to a time.Time.
+func UnixTimestamp(n int64) time.Time {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	switch {
+	case abs >= 1e17:
+		return time.Unix(0, n).UTC()
+	case abs >= 1e14:
+		return time.UnixMicro(n).UTC()
+	case abs >= 1e11:
+		return time.UnixMilli(n).UTC()
+	default:
+		return time.Unix(n, 0).UTC()
+	}
+}
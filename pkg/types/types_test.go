@@ -3,6 +3,7 @@ package types
 import (
 	"math/big"
 	"testing"
+	"time"
 )
 
 func TestU256(t *testing.T) {
@@ -36,3 +37,74 @@ func TestAddress(t *testing.T) {
 		t.Errorf("expected %s, got %s", addrStr, a.String())
 	}
 }
+
+func TestParseDecimal(t *testing.T) {
+	d, err := ParseDecimal(" 0.55 ")
+	if err != nil {
+		t.Fatalf("ParseDecimal failed: %v", err)
+	}
+	if d.String() != "0.55" {
+		t.Errorf("expected 0.55, got %s", d)
+	}
+
+	d, err = ParseDecimal("")
+	if err != nil || !d.IsZero() {
+		t.Errorf("expected zero without error, got %s (%v)", d, err)
+	}
+
+	if _, err := ParseDecimal("abc"); err == nil {
+		t.Error("expected error for invalid decimal")
+	}
+}
+
+func TestCachedDecimal(t *testing.T) {
+	c := NewCachedDecimal("0.55")
+	if got := c.Value("0.55"); got.String() != "0.55" {
+		t.Fatalf("Value = %s", got)
+	}
+	// A field changed after decoding is re-parsed rather than served stale.
+	if got := c.Value("0.6"); got.String() != "0.6" {
+		t.Fatalf("Value after mutation = %s", got)
+	}
+	bad := NewCachedDecimal("abc")
+	if !bad.Value("abc").IsZero() {
+		t.Fatal("unparseable value should yield zero")
+	}
+	if err := bad.Validate("price", "abc"); err == nil {
+		t.Fatal("expected Validate to report the unparseable value")
+	}
+	if err := c.Validate("price", "0.55"); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Unix(1700000000, 0).UTC()
+	tests := []struct {
+		name string
+		raw  string
+		want time.Time
+	}{
+		{"seconds", "1700000000", want},
+		{"millis", "1700000000000", want},
+		{"micros", "1700000000000000", want},
+		{"nanos", "1700000000000000000", want},
+		{"fractional", "1700000000.5", want.Add(500 * time.Millisecond)},
+		{"empty", "", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimestamp(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseTimestamp(%q) failed: %v", tt.raw, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTimestamp(%q) = %s, want %s", tt.raw, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseTimestamp("yesterday"); err == nil {
+		t.Error("expected error for invalid timestamp")
+	}
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/ctf"
+)
+
+var (
+	erc20ABI = &lazyABI{name: "erc20", raw: `[
+		{"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+		{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}
+	]`}
+	erc1155ABI = &lazyABI{name: "erc1155", raw: `[
+		{"inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"name":"setApprovalForAll","outputs":[],"stateMutability":"nonpayable","type":"function"}
+	]`}
+)
+
+// ApproveCall approves spender to move amount of an ERC-20 token, such as
+// USDC for the exchange or the conditional tokens contract.
+func ApproveCall(token, spender common.Address, amount *big.Int) (Call, error) {
+	if amount == nil {
+		return Call{}, ctf.ErrMissingU256Value
+	}
+	data, err := erc20ABI.pack("approve", spender, amount)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: token, Data: data}, nil
+}
+
+// TransferCall transfers amount of an ERC-20 token, such as USDC, to to.
+func TransferCall(token, to common.Address, amount *big.Int) (Call, error) {
+	if amount == nil {
+		return Call{}, ctf.ErrMissingU256Value
+	}
+	data, err := erc20ABI.pack("transfer", to, amount)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: token, Data: data}, nil
+}
+
+// SetApprovalForAllCall approves or revokes operator for every ERC-1155
+// position token held by the wallet.
+func SetApprovalForAllCall(token, operator common.Address, approved bool) (Call, error) {
+	data, err := erc1155ABI.pack("setApprovalForAll", operator, approved)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: token, Data: data}, nil
+}
+
+// SplitPositionCall splits collateral into outcome positions on the
+// conditional tokens contract.
+func SplitPositionCall(conditionalTokens common.Address, req *ctf.SplitPositionRequest) (Call, error) {
+	data, err := ctf.PackSplitPosition(req)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: conditionalTokens, Data: data}, nil
+}
+
+// MergePositionsCall merges outcome positions back into collateral.
+func MergePositionsCall(conditionalTokens common.Address, req *ctf.MergePositionsRequest) (Call, error) {
+	data, err := ctf.PackMergePositions(req)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: conditionalTokens, Data: data}, nil
+}
+
+// RedeemPositionsCall redeems resolved positions for collateral.
+func RedeemPositionsCall(conditionalTokens common.Address, req *ctf.RedeemPositionsRequest) (Call, error) {
+	data, err := ctf.PackRedeemPositions(req)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: conditionalTokens, Data: data}, nil
+}
+
+// RedeemNegRiskCall redeems resolved negative risk positions through the
+// neg risk adapter.
+func RedeemNegRiskCall(negRiskAdapter common.Address, req *ctf.RedeemNegRiskRequest) (Call, error) {
+	data, err := ctf.PackRedeemNegRisk(req)
+	if err != nil {
+		return Call{}, err
+	}
+	return Call{To: negRiskAdapter, Data: data}, nil
+}
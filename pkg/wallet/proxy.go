@@ -0,0 +1,196 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/relayer"
+)
+
+const (
+	// ProxyRelayHubAddress is the relay hub that executes relayed proxy calls.
+	ProxyRelayHubAddress = "0xD216153c06E857cD7f72665E0aF1d7D82172F494"
+	// DefaultProxyRelayGasLimit is the gas limit signed into relayed proxy calls.
+	DefaultProxyRelayGasLimit = 10_000_000
+
+	proxyCallTypeCall uint8 = 1
+)
+
+var proxyFactoryABI = &lazyABI{name: "proxy factory", raw: `[
+	{"inputs":[{"components":[{"name":"typeCode","type":"uint8"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"proxy","outputs":[{"name":"returnValues","type":"bytes[]"}],"stateMutability":"payable","type":"function"}
+]`}
+
+// proxyCall mirrors the factory's ProxyCall tuple.
+type proxyCall struct {
+	TypeCode uint8
+	To       common.Address
+	Value    *big.Int
+	Data     []byte
+}
+
+type digestSigner interface {
+	SignDigest([]byte) ([]byte, error)
+}
+
+// Proxy builds transactions for a Polymarket proxy wallet. The proxy factory
+// forwards calls to the wallet of the sending (or relayed) owner, deploying
+// it on first use.
+type Proxy struct {
+	owner   auth.Signer
+	address common.Address
+	factory common.Address
+	// GasLimit is signed into relayed calls. Defaults to
+	// DefaultProxyRelayGasLimit.
+	GasLimit uint64
+}
+
+// NewProxy returns the proxy wallet derived from owner's address. Proxy
+// wallets are only deployed on Polygon.
+func NewProxy(owner auth.Signer) (*Proxy, error) {
+	if owner == nil {
+		return nil, ErrMissingSigner
+	}
+	address, err := auth.DeriveProxyWalletForChain(owner.Address(), owner.ChainID().Int64())
+	if err != nil {
+		return nil, err
+	}
+	return &Proxy{
+		owner:    owner,
+		address:  address,
+		factory:  common.HexToAddress(auth.ProxyFactoryAddress),
+		GasLimit: DefaultProxyRelayGasLimit,
+	}, nil
+}
+
+// Address returns the proxy wallet address.
+func (p *Proxy) Address() common.Address {
+	return p.address
+}
+
+// Tx returns the proxy-factory call that executes calls from the owner's
+// proxy wallet. It must be sent from the owner's EOA.
+func (p *Proxy) Tx(calls ...Call) (Tx, error) {
+	if len(calls) == 0 {
+		return Tx{}, fmt.Errorf("at least one call is required")
+	}
+	wrapped := make([]proxyCall, len(calls))
+	for i, call := range calls {
+		wrapped[i] = proxyCall{TypeCode: proxyCallTypeCall, To: call.To, Value: orZero(call.Value), Data: call.Data}
+	}
+	data, err := proxyFactoryABI.pack("proxy", wrapped)
+	if err != nil {
+		return Tx{}, err
+	}
+	return Tx{To: p.factory, Data: data}, nil
+}
+
+// Exec sends calls through the proxy factory from txOpts.From, which must be
+// the owner, and waits for the receipt.
+func (p *Proxy) Exec(ctx context.Context, backend Backend, txOpts *bind.TransactOpts, calls ...Call) (*gethtypes.Receipt, error) {
+	if txOpts != nil && txOpts.From != p.owner.Address() {
+		return nil, fmt.Errorf("proxy calls must be sent by the owner %s, got %s", p.owner.Address().Hex(), txOpts.From.Hex())
+	}
+	tx, err := p.Tx(calls...)
+	if err != nil {
+		return nil, err
+	}
+	return Send(ctx, backend, txOpts, tx)
+}
+
+// RelayRequest builds a signed relayer submission for calls, using the relay
+// address and nonce reported by the relayer. The owner must be able to sign
+// raw digests (see auth.PrivateKeySigner.SignDigest).
+func (p *Proxy) RelayRequest(ctx context.Context, client relayer.Client, calls ...Call) (*relayer.SubmitRequest, error) {
+	if client == nil {
+		return nil, fmt.Errorf("relayer client is required")
+	}
+	ds, ok := p.owner.(digestSigner)
+	if !ok {
+		return nil, fmt.Errorf("%w: proxy relay requires a signer with SignDigest", ErrMissingSigner)
+	}
+	tx, err := p.Tx(calls...)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := client.GetRelayPayload(ctx, p.owner.Address().Hex())
+	if err != nil {
+		return nil, fmt.Errorf("get relay payload: %w", err)
+	}
+	nonce, ok := new(big.Int).SetString(payload.Nonce, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid relayer nonce %q", payload.Nonce)
+	}
+	if !common.IsHexAddress(payload.RelayerAddress) {
+		return nil, fmt.Errorf("invalid relayer address %q", payload.RelayerAddress)
+	}
+	relay := common.HexToAddress(payload.RelayerAddress)
+	relayHub := common.HexToAddress(ProxyRelayHubAddress)
+	gasLimit := p.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultProxyRelayGasLimit
+	}
+
+	hash := proxyRelayHash(p.owner.Address(), tx, new(big.Int), new(big.Int), new(big.Int).SetUint64(gasLimit), nonce, relayHub, relay)
+	sig, err := ds.SignDigest(accounts.TextHash(hash.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("sign proxy relay: %w", err)
+	}
+	signature := hexutil.Encode(sig)
+	return &relayer.SubmitRequest{
+		Transaction: map[string]interface{}{
+			"type":        "PROXY",
+			"from":        p.owner.Address().Hex(),
+			"to":          tx.To.Hex(),
+			"proxyWallet": p.address.Hex(),
+			"data":        hexutil.Encode(tx.Data),
+			"nonce":       nonce.String(),
+			"signature":   signature,
+			"signatureParams": map[string]string{
+				"gasPrice":   "0",
+				"gasLimit":   fmt.Sprintf("%d", gasLimit),
+				"relayerFee": "0",
+				"relayHub":   relayHub.Hex(),
+				"relay":      relay.Hex(),
+			},
+		},
+		Signature: signature,
+	}, nil
+}
+
+// Relay builds a signed relayer submission for calls and submits it for
+// gasless execution.
+func (p *Proxy) Relay(ctx context.Context, client relayer.Client, calls ...Call) (*relayer.SubmitResponse, error) {
+	req, err := p.RelayRequest(ctx, client, calls...)
+	if err != nil {
+		return nil, err
+	}
+	return client.Submit(ctx, req)
+}
+
+// proxyRelayHash is the hash the owner signs to authorize a relayed call:
+// keccak256("rlx:" ++ from ++ to ++ data ++ txFee ++ gasPrice ++ gasLimit ++
+// nonce ++ relayHub ++ relay).
+func proxyRelayHash(from common.Address, tx Tx, txFee, gasPrice, gasLimit, nonce *big.Int, relayHub, relay common.Address) common.Hash {
+	return crypto.Keccak256Hash(
+		[]byte("rlx:"),
+		from.Bytes(),
+		tx.To.Bytes(),
+		tx.Data,
+		math.U256Bytes(txFee),
+		math.U256Bytes(gasPrice),
+		math.U256Bytes(gasLimit),
+		math.U256Bytes(new(big.Int).Set(nonce)),
+		relayHub.Bytes(),
+		relay.Bytes(),
+	)
+}
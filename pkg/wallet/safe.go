@@ -0,0 +1,320 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/relayer"
+)
+
+// SafeMultiSendAddress is the Safe MultiSend contract used to batch several
+// calls into one Safe transaction.
+const SafeMultiSendAddress = "0xA238CBeb142c10Ef7Ad8442C6D1f9E89e07e7761"
+
+// Operation is the Safe call type.
+type Operation uint8
+
+const (
+	OperationCall         Operation = 0
+	OperationDelegateCall Operation = 1
+)
+
+var (
+	safeABI = &lazyABI{name: "safe", raw: `[
+		{"inputs":[],"name":"nonce","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+		{"inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],"name":"execTransaction","outputs":[{"name":"success","type":"bool"}],"stateMutability":"payable","type":"function"}
+	]`}
+	multiSendABI = &lazyABI{name: "multisend", raw: `[
+		{"inputs":[{"name":"transactions","type":"bytes"}],"name":"multiSend","outputs":[],"stateMutability":"payable","type":"function"}
+	]`}
+)
+
+var safeTxTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"SafeTx": {
+		{Name: "to", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "data", Type: "bytes"},
+		{Name: "operation", Type: "uint8"},
+		{Name: "safeTxGas", Type: "uint256"},
+		{Name: "baseGas", Type: "uint256"},
+		{Name: "gasPrice", Type: "uint256"},
+		{Name: "gasToken", Type: "address"},
+		{Name: "refundReceiver", Type: "address"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// SafeTx is a Gnosis Safe transaction. Gas fields default to zero, which
+// makes the submitter pay for gas without refund.
+type SafeTx struct {
+	To             common.Address
+	Value          *big.Int
+	Data           []byte
+	Operation      Operation
+	SafeTxGas      *big.Int
+	BaseGas        *big.Int
+	GasPrice       *big.Int
+	GasToken       common.Address
+	RefundReceiver common.Address
+	Nonce          *big.Int
+	// Signature is the owner's EIP-712 signature, set by Safe.Sign.
+	Signature []byte
+}
+
+// Safe builds transactions for a single-owner Polymarket Gnosis Safe.
+type Safe struct {
+	owner   auth.Signer
+	address common.Address
+}
+
+// NewSafe returns the Safe derived from owner's address on owner's chain.
+func NewSafe(owner auth.Signer) (*Safe, error) {
+	if owner == nil {
+		return nil, ErrMissingSigner
+	}
+	address, err := auth.DeriveSafeWalletForChain(owner.Address(), owner.ChainID().Int64())
+	if err != nil {
+		return nil, err
+	}
+	return &Safe{owner: owner, address: address}, nil
+}
+
+// NewSafeAt returns a Safe at an explicit address owned by owner.
+func NewSafeAt(owner auth.Signer, address common.Address) (*Safe, error) {
+	if owner == nil {
+		return nil, ErrMissingSigner
+	}
+	return &Safe{owner: owner, address: address}, nil
+}
+
+// Address returns the Safe address.
+func (s *Safe) Address() common.Address {
+	return s.address
+}
+
+// Nonce reads the Safe's current transaction nonce from the chain.
+func (s *Safe) Nonce(ctx context.Context, caller bind.ContractCaller) (*big.Int, error) {
+	if caller == nil {
+		return nil, fmt.Errorf("wallet backend is required")
+	}
+	data, err := safeABI.pack("nonce")
+	if err != nil {
+		return nil, err
+	}
+	out, err := caller.CallContract(ctx, ethereum.CallMsg{To: &s.address, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read safe nonce: %w", err)
+	}
+	parsed, err := safeABI.get()
+	if err != nil {
+		return nil, err
+	}
+	values, err := parsed.Unpack("nonce", out)
+	if err != nil || len(values) != 1 {
+		return nil, fmt.Errorf("decode safe nonce: %w", err)
+	}
+	nonce, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("decode safe nonce: unexpected type %T", values[0])
+	}
+	return nonce, nil
+}
+
+// NewTx wraps calls into an unsigned SafeTx with the given nonce. A single
+// call is executed directly; several calls are batched through MultiSend.
+func (s *Safe) NewTx(nonce *big.Int, calls ...Call) (*SafeTx, error) {
+	if nonce == nil {
+		return nil, fmt.Errorf("safe nonce is required")
+	}
+	switch len(calls) {
+	case 0:
+		return nil, fmt.Errorf("at least one call is required")
+	case 1:
+		return &SafeTx{To: calls[0].To, Value: orZero(calls[0].Value), Data: calls[0].Data, Operation: OperationCall, Nonce: nonce}, nil
+	}
+	var packed []byte
+	for _, call := range calls {
+		packed = append(packed, byte(OperationCall))
+		packed = append(packed, call.To.Bytes()...)
+		packed = append(packed, math.U256Bytes(new(big.Int).Set(orZero(call.Value)))...)
+		packed = append(packed, math.U256Bytes(big.NewInt(int64(len(call.Data))))...)
+		packed = append(packed, call.Data...)
+	}
+	data, err := multiSendABI.pack("multiSend", packed)
+	if err != nil {
+		return nil, err
+	}
+	return &SafeTx{
+		To:        common.HexToAddress(SafeMultiSendAddress),
+		Value:     new(big.Int),
+		Data:      data,
+		Operation: OperationDelegateCall,
+		Nonce:     nonce,
+	}, nil
+}
+
+// TypedData returns the EIP-712 SafeTx typed data for tx.
+func (s *Safe) TypedData(tx *SafeTx) apitypes.TypedData {
+	u256 := func(v *big.Int) *math.HexOrDecimal256 {
+		if v == nil {
+			v = new(big.Int)
+		}
+		return (*math.HexOrDecimal256)(v)
+	}
+	return apitypes.TypedData{
+		Types:       safeTxTypes,
+		PrimaryType: "SafeTx",
+		Domain:      s.domain(),
+		Message: apitypes.TypedDataMessage{
+			"to":             tx.To.Hex(),
+			"value":          u256(tx.Value),
+			"data":           hexutil.Encode(tx.Data),
+			"operation":      u256(big.NewInt(int64(tx.Operation))),
+			"safeTxGas":      u256(tx.SafeTxGas),
+			"baseGas":        u256(tx.BaseGas),
+			"gasPrice":       u256(tx.GasPrice),
+			"gasToken":       tx.GasToken.Hex(),
+			"refundReceiver": tx.RefundReceiver.Hex(),
+			"nonce":          u256(tx.Nonce),
+		},
+	}
+}
+
+// Hash returns the EIP-712 SafeTx hash the owner signs.
+func (s *Safe) Hash(tx *SafeTx) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(s.TypedData(tx))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("hash safe tx: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// Sign signs tx with the owner key and stores the signature in tx.
+func (s *Safe) Sign(tx *SafeTx) error {
+	typed := s.TypedData(tx)
+	sig, err := s.owner.SignTypedData(&typed.Domain, typed.Types, typed.Message, typed.PrimaryType)
+	if err != nil {
+		return fmt.Errorf("sign safe tx: %w", err)
+	}
+	tx.Signature = sig
+	return nil
+}
+
+// ExecTx returns the execTransaction call for a signed tx.
+func (s *Safe) ExecTx(tx *SafeTx) (Tx, error) {
+	if len(tx.Signature) == 0 {
+		return Tx{}, fmt.Errorf("safe tx is not signed")
+	}
+	data, err := safeABI.pack("execTransaction",
+		tx.To, orZero(tx.Value), tx.Data, uint8(tx.Operation), orZero(tx.SafeTxGas), orZero(tx.BaseGas),
+		orZero(tx.GasPrice), tx.GasToken, tx.RefundReceiver, tx.Signature)
+	if err != nil {
+		return Tx{}, err
+	}
+	return Tx{To: s.address, Data: data}, nil
+}
+
+// Exec reads the nonce from backend, signs calls as one SafeTx and sends
+// execTransaction from txOpts.From, waiting for the receipt.
+func (s *Safe) Exec(ctx context.Context, backend Backend, txOpts *bind.TransactOpts, calls ...Call) (*gethtypes.Receipt, error) {
+	nonce, err := s.Nonce(ctx, backend)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.NewTx(nonce, calls...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Sign(tx); err != nil {
+		return nil, err
+	}
+	exec, err := s.ExecTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	return Send(ctx, backend, txOpts, exec)
+}
+
+// RelayRequest returns the relayer submission for a signed tx.
+func (s *Safe) RelayRequest(tx *SafeTx) (*relayer.SubmitRequest, error) {
+	if len(tx.Signature) == 0 {
+		return nil, fmt.Errorf("safe tx is not signed")
+	}
+	signature := hexutil.Encode(tx.Signature)
+	return &relayer.SubmitRequest{
+		Transaction: map[string]interface{}{
+			"type":        "SAFE",
+			"from":        s.owner.Address().Hex(),
+			"to":          tx.To.Hex(),
+			"proxyWallet": s.address.Hex(),
+			"data":        hexutil.Encode(tx.Data),
+			"nonce":       orZero(tx.Nonce).String(),
+			"signature":   signature,
+			"signatureParams": map[string]string{
+				"operation":      fmt.Sprintf("%d", tx.Operation),
+				"safeTxnGas":     orZero(tx.SafeTxGas).String(),
+				"baseGas":        orZero(tx.BaseGas).String(),
+				"gasPrice":       orZero(tx.GasPrice).String(),
+				"gasToken":       tx.GasToken.Hex(),
+				"refundReceiver": tx.RefundReceiver.Hex(),
+			},
+		},
+		Signature: signature,
+	}, nil
+}
+
+// Relay looks up the nonce from the relayer, signs calls as one SafeTx and
+// submits it for gasless execution.
+func (s *Safe) Relay(ctx context.Context, client relayer.Client, calls ...Call) (*relayer.SubmitResponse, error) {
+	if client == nil {
+		return nil, fmt.Errorf("relayer client is required")
+	}
+	resp, err := client.GetNonce(ctx, s.owner.Address().Hex())
+	if err != nil {
+		return nil, fmt.Errorf("get safe nonce: %w", err)
+	}
+	nonce, ok := new(big.Int).SetString(resp.Nonce, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid relayer nonce %q", resp.Nonce)
+	}
+	tx, err := s.NewTx(nonce, calls...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Sign(tx); err != nil {
+		return nil, err
+	}
+	req, err := s.RelayRequest(tx)
+	if err != nil {
+		return nil, err
+	}
+	return client.Submit(ctx, req)
+}
+
+func (s *Safe) domain() apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		ChainId:           (*math.HexOrDecimal256)(s.owner.ChainID()),
+		VerifyingContract: s.address.Hex(),
+	}
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
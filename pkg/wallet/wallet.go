@@ -0,0 +1,111 @@
+// Package wallet builds on-chain transactions for Polymarket smart-contract
+// wallets. Calls such as approvals, CTF split, merge and redeem, and USDC
+// transfers are wrapped into a Gnosis Safe execTransaction or a Polymarket
+// proxy-factory proxy call. The result can be sent from the owner's EOA
+// through a Backend, or handed to the gasless relayer via relayer.Client.Submit.
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	sdkerrors "github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/errors"
+)
+
+// Use unified error definitions from pkg/errors
+var (
+	ErrMissingSigner          = sdkerrors.ErrMissingSigner
+	ErrMissingTransactor      = sdkerrors.ErrMissingTransactor
+	ErrProxyWalletUnsupported = sdkerrors.ErrProxyWalletUnsupported
+	ErrSafeWalletUnsupported  = sdkerrors.ErrSafeWalletUnsupported
+)
+
+// Backend combines contract and receipt backends needed for transactions.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Call is a single contract call executed by a wallet.
+type Call struct {
+	To common.Address
+	// Value is the amount of native token sent with the call. Nil means zero.
+	Value *big.Int
+	Data  []byte
+}
+
+// Tx is a transaction the wallet owner's EOA sends to execute wallet calls.
+type Tx struct {
+	To   common.Address
+	Data []byte
+}
+
+// Send submits tx from txOpts.From and waits for it to be mined. A reverted
+// transaction is reported as an error together with its receipt.
+func Send(ctx context.Context, backend Backend, txOpts *bind.TransactOpts, tx Tx) (*gethtypes.Receipt, error) {
+	if backend == nil {
+		return nil, fmt.Errorf("wallet backend is required")
+	}
+	if txOpts == nil {
+		return nil, ErrMissingTransactor
+	}
+	opts := *txOpts
+	opts.Context = ctx
+
+	contract := bind.NewBoundContract(tx.To, abi.ABI{}, backend, backend, backend)
+	sent, err := contract.RawTransact(&opts, tx.Data)
+	if err != nil {
+		return nil, fmt.Errorf("send wallet transaction: %w", err)
+	}
+	receipt, err := bind.WaitMined(ctx, backend, sent)
+	if err != nil {
+		return nil, fmt.Errorf("wait wallet transaction receipt: %w", err)
+	}
+	if receipt == nil || receipt.BlockNumber == nil {
+		return nil, errors.New("receipt missing block number")
+	}
+	if receipt.Status != gethtypes.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("wallet transaction %s reverted", sent.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// lazyABI parses a contract ABI on first use.
+type lazyABI struct {
+	name   string
+	raw    string
+	once   sync.Once
+	parsed abi.ABI
+	err    error
+}
+
+func (l *lazyABI) get() (abi.ABI, error) {
+	l.once.Do(func() {
+		l.parsed, l.err = abi.JSON(strings.NewReader(l.raw))
+	})
+	if l.err != nil {
+		return abi.ABI{}, fmt.Errorf("parse %s abi: %w", l.name, l.err)
+	}
+	return l.parsed, nil
+}
+
+func (l *lazyABI) pack(method string, args ...interface{}) ([]byte, error) {
+	parsed, err := l.get()
+	if err != nil {
+		return nil, err
+	}
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("pack %s.%s: %w", l.name, method, err)
+	}
+	return data, nil
+}
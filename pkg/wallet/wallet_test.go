@@ -0,0 +1,264 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/auth"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/ctf"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/relayer"
+	"github.com/GoPolymarket/polymarket-go-sdk/v2/pkg/transport"
+)
+
+var (
+	usdc      = common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174")
+	exchange  = common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E")
+	condToken = common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045")
+)
+
+func mustSigner(t *testing.T) *auth.PrivateKeySigner {
+	t.Helper()
+	signer, err := auth.NewPrivateKeySigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318", 137)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+	return signer
+}
+
+func recoverSigner(t *testing.T, digest, sig []byte) common.Address {
+	t.Helper()
+	sig = bytes.Clone(sig)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		t.Fatalf("recover: %v", err)
+	}
+	return crypto.PubkeyToAddress(*pub)
+}
+
+type nonceCaller struct {
+	nonce int64
+}
+
+func (c nonceCaller) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c nonceCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return math.U256Bytes(big.NewInt(c.nonce)), nil
+}
+
+var _ bind.ContractCaller = nonceCaller{}
+
+func TestSafeTxSigning(t *testing.T) {
+	signer := mustSigner(t)
+	safe, err := NewSafe(signer)
+	if err != nil {
+		t.Fatalf("NewSafe: %v", err)
+	}
+	derived, _ := auth.DeriveSafeWallet(signer.Address())
+	if safe.Address() != derived {
+		t.Fatalf("safe address %s, want %s", safe.Address().Hex(), derived.Hex())
+	}
+
+	nonce, err := safe.Nonce(context.Background(), nonceCaller{nonce: 7})
+	if err != nil || nonce.Int64() != 7 {
+		t.Fatalf("Nonce: %v %v", nonce, err)
+	}
+	approve, err := ApproveCall(usdc, exchange, big.NewInt(1_000_000))
+	if err != nil {
+		t.Fatalf("ApproveCall: %v", err)
+	}
+	tx, err := safe.NewTx(nonce, approve)
+	if err != nil {
+		t.Fatalf("NewTx: %v", err)
+	}
+	if tx.To != usdc || tx.Operation != OperationCall {
+		t.Fatalf("single call should execute directly, got %+v", tx)
+	}
+
+	// Hash the SafeTx by hand with the Safe contract's type hashes.
+	word := func(v *big.Int) []byte { return math.U256Bytes(new(big.Int).Set(v)) }
+	addr := func(a common.Address) []byte { return common.LeftPadBytes(a.Bytes(), 32) }
+	domainSeparator := crypto.Keccak256(
+		common.FromHex("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218"),
+		word(big.NewInt(137)), addr(safe.Address()))
+	structHash := crypto.Keccak256(
+		common.FromHex("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8"),
+		addr(tx.To), word(new(big.Int)), crypto.Keccak256(tx.Data), word(new(big.Int)),
+		word(new(big.Int)), word(new(big.Int)), word(new(big.Int)), addr(common.Address{}), addr(common.Address{}),
+		word(big.NewInt(7)))
+	want := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+	hash, err := safe.Hash(tx)
+	if err != nil || hash != want {
+		t.Fatalf("safe tx hash %s, want %s (%v)", hash.Hex(), want.Hex(), err)
+	}
+
+	if _, err := safe.ExecTx(tx); err == nil {
+		t.Fatal("expected error for unsigned tx")
+	}
+	if err := safe.Sign(tx); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if got := recoverSigner(t, hash.Bytes(), tx.Signature); got != signer.Address() {
+		t.Fatalf("signature recovers %s, want owner", got.Hex())
+	}
+	exec, err := safe.ExecTx(tx)
+	if err != nil {
+		t.Fatalf("ExecTx: %v", err)
+	}
+	if exec.To != safe.Address() || !bytes.Equal(exec.Data[:4], crypto.Keccak256([]byte("execTransaction(address,uint256,bytes,uint8,uint256,uint256,uint256,address,address,bytes)"))[:4]) {
+		t.Fatalf("unexpected exec tx to %s selector %x", exec.To.Hex(), exec.Data[:4])
+	}
+}
+
+func TestSafeMultiSend(t *testing.T) {
+	safe, err := NewSafe(mustSigner(t))
+	if err != nil {
+		t.Fatalf("NewSafe: %v", err)
+	}
+	approve, _ := ApproveCall(usdc, condToken, big.NewInt(5))
+	split, err := SplitPositionCall(condToken, &ctf.SplitPositionRequest{
+		CollateralToken: usdc,
+		ConditionID:     common.HexToHash("0x01"),
+		Partition:       ctf.BinaryPartition,
+		Amount:          big.NewInt(5),
+	})
+	if err != nil {
+		t.Fatalf("SplitPositionCall: %v", err)
+	}
+	tx, err := safe.NewTx(big.NewInt(0), approve, split)
+	if err != nil {
+		t.Fatalf("NewTx: %v", err)
+	}
+	if tx.To != common.HexToAddress(SafeMultiSendAddress) || tx.Operation != OperationDelegateCall {
+		t.Fatalf("expected multisend delegatecall, got %+v", tx)
+	}
+	parsed, _ := multiSendABI.get()
+	args, err := parsed.Methods["multiSend"].Inputs.Unpack(tx.Data[4:])
+	if err != nil {
+		t.Fatalf("unpack multiSend: %v", err)
+	}
+	packed := args[0].([]byte)
+	first := 1 + 20 + 32 + 32 + len(approve.Data)
+	if len(packed) != first+1+20+32+32+len(split.Data) {
+		t.Fatalf("unexpected multisend payload length %d", len(packed))
+	}
+	if common.BytesToAddress(packed[1:21]) != usdc || common.BytesToAddress(packed[first+1:first+21]) != condToken {
+		t.Fatal("multisend targets out of order")
+	}
+}
+
+func TestSafeRelay(t *testing.T) {
+	var submitted relayer.SubmitRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /nonce", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(relayer.NonceResponse{Nonce: "3"})
+	})
+	mux.HandleFunc("POST /submit", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&submitted)
+		json.NewEncoder(w).Encode(relayer.SubmitResponse{TransactionID: "tx-1", State: "STATE_NEW"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	signer := mustSigner(t)
+	safe, _ := NewSafe(signer)
+	transfer, _ := TransferCall(usdc, exchange, big.NewInt(10))
+	resp, err := safe.Relay(context.Background(), relayer.NewClient(transport.NewClient(srv.Client(), srv.URL)), transfer)
+	if err != nil {
+		t.Fatalf("Relay: %v", err)
+	}
+	if resp.TransactionID != "tx-1" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	got := submitted.Transaction
+	if got["type"] != "SAFE" || got["nonce"] != "3" || got["proxyWallet"] != safe.Address().Hex() || got["to"] != usdc.Hex() {
+		t.Fatalf("unexpected relay transaction %+v", got)
+	}
+
+	tx, _ := safe.NewTx(big.NewInt(3), transfer)
+	hash, _ := safe.Hash(tx)
+	sig, err := hexutil.Decode(submitted.Signature)
+	if err != nil || recoverSigner(t, hash.Bytes(), sig) != signer.Address() {
+		t.Fatalf("relay signature does not recover the owner (%v)", err)
+	}
+}
+
+func TestProxyTxAndRelay(t *testing.T) {
+	signer := mustSigner(t)
+	proxy, err := NewProxy(signer)
+	if err != nil {
+		t.Fatalf("NewProxy: %v", err)
+	}
+	derived, _ := auth.DeriveProxyWallet(signer.Address())
+	if proxy.Address() != derived {
+		t.Fatalf("proxy address %s, want %s", proxy.Address().Hex(), derived.Hex())
+	}
+
+	approval, _ := SetApprovalForAllCall(condToken, exchange, true)
+	tx, err := proxy.Tx(approval)
+	if err != nil {
+		t.Fatalf("Tx: %v", err)
+	}
+	if tx.To != common.HexToAddress(auth.ProxyFactoryAddress) ||
+		!bytes.Equal(tx.Data[:4], crypto.Keccak256([]byte("proxy((uint8,address,uint256,bytes)[])"))[:4]) {
+		t.Fatalf("unexpected proxy tx to %s selector %x", tx.To.Hex(), tx.Data[:4])
+	}
+	other := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	if _, err := proxy.Exec(context.Background(), nil, &bind.TransactOpts{From: other}, approval); err == nil {
+		t.Fatal("expected error when sender is not the owner")
+	}
+
+	relay := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /relay-payload", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(relayer.RelayPayloadResponse{RelayerAddress: relay.Hex(), Nonce: "12"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	req, err := proxy.RelayRequest(context.Background(), relayer.NewClient(transport.NewClient(srv.Client(), srv.URL)), approval)
+	if err != nil {
+		t.Fatalf("RelayRequest: %v", err)
+	}
+	if req.Transaction["type"] != "PROXY" || req.Transaction["nonce"] != "12" || req.Transaction["to"] != tx.To.Hex() {
+		t.Fatalf("unexpected relay transaction %+v", req.Transaction)
+	}
+	hash := proxyRelayHash(signer.Address(), tx, new(big.Int), new(big.Int), big.NewInt(DefaultProxyRelayGasLimit), big.NewInt(12),
+		common.HexToAddress(ProxyRelayHubAddress), relay)
+	sig, _ := hexutil.Decode(req.Signature)
+	if recoverSigner(t, accounts.TextHash(hash.Bytes()), sig) != signer.Address() {
+		t.Fatal("proxy relay signature does not recover the owner")
+	}
+}
+
+func TestCallValidation(t *testing.T) {
+	if _, err := ApproveCall(usdc, exchange, nil); !errors.Is(err, ctf.ErrMissingU256Value) {
+		t.Fatalf("expected ErrMissingU256Value, got %v", err)
+	}
+	if _, err := RedeemPositionsCall(condToken, nil); !errors.Is(err, ctf.ErrMissingRequest) {
+		t.Fatalf("expected ErrMissingRequest, got %v", err)
+	}
+	transfer, _ := TransferCall(usdc, exchange, big.NewInt(1))
+	if !bytes.Equal(transfer.Data[:4], common.FromHex("0xa9059cbb")) {
+		t.Fatalf("unexpected transfer selector %x", transfer.Data[:4])
+	}
+	if _, err := NewSafe(nil); !errors.Is(err, ErrMissingSigner) {
+		t.Fatalf("expected ErrMissingSigner, got %v", err)
+	}
+}